@@ -1,30 +1,53 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"net"
+	nethttp "net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/config"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/cache"
 	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/database"
-	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/http"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/email"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/eventos"
+	grpcAdp "github.com/flaviorodolfo/transfeera-challenge/internal/infra/grpc"
+	httpAdp "github.com/flaviorodolfo/transfeera-challenge/internal/infra/http"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/limite"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/listarestritiva"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/sqlite"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/webhook"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/mascara"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
-func construirDatabaseUri() string {
-	return fmt.Sprintf("host=%s port=%s user=%s "+
-		"password=%s dbname=%s sslmode=disable",
-		os.Getenv("DATABASE_HOST"), os.Getenv("DATABASE_PORT"), os.Getenv("DATABASE_USER"), os.Getenv("DATABASE_PASS"), os.Getenv("DATABASE_NAME"))
-}
-
-func initializeDatabase(logger *zap.Logger) (*sql.DB, error) {
-	db, err := sql.Open("postgres", construirDatabaseUri())
+func initializeDatabase(cfg config.DatabaseConfig, logger *zap.Logger) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.DatabaseUri())
 	if err != nil {
 		logger.Error("Abrindo a conexão com o banco de dados", zap.Error(err))
 		return nil, err
 	}
+	db.SetMaxOpenConns(cfg.MaxConexoesAbertas)
+	db.SetMaxIdleConns(cfg.MaxConexoesOciosas)
+	db.SetConnMaxLifetime(cfg.TempoVidaConexao)
 
 	if err := db.Ping(); err != nil {
 		logger.Error("Erro ao pingar o banco de dados", zap.Error(err))
@@ -33,37 +56,408 @@ func initializeDatabase(logger *zap.Logger) (*sql.DB, error) {
 
 	return db, nil
 }
-func inicializarLog() *zap.Logger {
-	var logger *zap.Logger
-	var err error
-	if os.Getenv("ENV") == "DEV" {
-		logger, err = zap.NewDevelopment()
+func inicializarLog(cfg *config.Config) *zap.Logger {
+	var zapConfig zap.Config
+	if cfg.Ambiente == "DEV" {
+		zapConfig = zap.NewDevelopmentConfig()
 	} else {
-		logger, err = zap.NewProduction()
+		zapConfig = zap.NewProductionConfig()
+	}
+	nivel, err := zap.ParseAtomicLevel(cfg.LogLevel)
+	if err != nil {
+		log.Fatalf("Erro ao inicializar o logger: %v", err)
 	}
+	zapConfig.Level = nivel
+	//os dados pessoais são mascarados em todos os logs
+	logger, err := zapConfig.Build(zap.WrapCore(mascara.NewCore))
 	if err != nil {
 		log.Fatalf("Erro ao inicializar o logger: %v", err)
 	}
 	return logger
 }
-func run() error {
-	logger := inicializarLog()
-	db, err := initializeDatabase(logger)
+
+// publicador dos eventos do outbox configurado (stdout, arquivo ou http),
+// os webhooks sempre recebem os eventos
+func inicializarPublicador(cfg config.EventosConfig, webhookService *app.WebhookService) (domain.PublicadorEventos, error) {
+	publicadores := eventos.PublicadorMultiplo{webhookService}
+	switch cfg.Publicador {
+	case "stdout":
+		publicadores = append(publicadores, eventos.NewPublicadorStdout())
+	case "arquivo":
+		publicador, err := eventos.NewPublicadorArquivo(cfg.Arquivo)
+		if err != nil {
+			return nil, err
+		}
+		publicadores = append(publicadores, publicador)
+	case "http":
+		publicadores = append(publicadores, eventos.NewPublicadorHTTP(cfg.Url))
+	}
+	return publicadores, nil
+}
+
+// retorna o mailer dos emails de verificação, nil se a verificação está desabilitada
+func inicializarMailer(cfg config.EmailConfig) (domain.Mailer, error) {
+	switch cfg.Mailer {
+	case "arquivo":
+		return email.NewMailerArquivo(cfg.Diretorio, cfg.Remetente)
+	case "smtp":
+		return email.NewMailerSMTP(cfg.SmtpHost, cfg.SmtpPorta, cfg.SmtpUsuario, cfg.SmtpSenha, cfg.Remetente), nil
+	}
+	return nil, nil
+}
+
+// atende as requisições até o contexto ser cancelado, quando para de aceitar novas conexões
+// e aguarda as requisições em andamento por até ShutdownTimeout
+func servir(ctx context.Context, server *nethttp.Server, cfg config.HttpConfig, logger *zap.Logger) error {
+	erros := make(chan error, 1)
+	go func() {
+		logger.Info("servidor iniciado", zap.String("endereco", cfg.Endereco), zap.Bool("tls", cfg.TlsCert != ""))
+		if cfg.TlsCert != "" {
+			erros <- server.ListenAndServeTLS(cfg.TlsCert, cfg.TlsKey)
+		} else {
+			erros <- server.ListenAndServe()
+		}
+	}()
+	select {
+	case err := <-erros:
+		return err
+	case <-ctx.Done():
+	}
+	logger.Info("encerrando servidor")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	return server.Shutdown(shutdownCtx)
+}
+
+func run(cfg *config.Config) error {
+	logger := inicializarLog(cfg)
+	defer logger.Sync()
+	encerrarRastreamento, err := rastreamento.Inicializar(context.Background(), cfg.Rastreamento.Exportador, cfg.Rastreamento.Endpoint, cfg.Rastreamento.Amostragem)
+	if err != nil {
+		logger.Error("inicializando rastreamento", zap.Error(err))
+		return err
+	}
+	defer func() {
+		//envia os spans pendentes antes de encerrar
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Http.ShutdownTimeout)
+		defer cancel()
+		if err := encerrarRastreamento(ctx); err != nil {
+			logger.Error("encerrando rastreamento", zap.Error(err))
+		}
+	}()
+	//SIGTERM é enviado pelo orquestrador antes de encerrar o contêiner
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	//as rotinas em segundo plano são aguardadas antes de fechar o banco de dados
+	var wg sync.WaitGroup
+	var userRepo domain.RecebedorRepository
+	var ocorrenciaRepo domain.OcorrenciaRepository
+	var opcoesRouter []httpAdp.OpcaoRouter
+	var limitador limite.Limitador = limite.NewMemoria()
+	switch cfg.Repositorio {
+	case config.RepositorioMemoria:
+		logger.Warn("usando o repositório em memória: os recebedores são perdidos ao encerrar e os eventos não são publicados")
+		memoriaRepo := memoria.NewRecebedorRepository()
+		if err := metricas.RegistrarRecebedores(memoriaRepo); err != nil {
+			logger.Error("registrando métricas dos recebedores", zap.Error(err))
+			return err
+		}
+		userRepo = memoriaRepo
+		ocorrenciaRepo = memoria.NewOcorrenciaRepository()
+	case config.RepositorioSqlite:
+		logger.Warn("usando o repositório SQLite: os eventos não são publicados", zap.String("arquivo", cfg.Sqlite.Arquivo))
+		db, err := sqlite.Abrir(ctx, cfg.Sqlite.Arquivo)
+		if err != nil {
+			logger.Error("abrindo o banco de dados SQLite", zap.Error(err))
+			return err
+		}
+		defer db.Close()
+		sqliteRepo := sqlite.NewRecebedorRepository(db)
+		if err := metricas.RegistrarDatabase(db, "sqlite", sqliteRepo); err != nil {
+			logger.Error("registrando métricas do banco de dados", zap.Error(err))
+			return err
+		}
+		userRepo = sqliteRepo
+		ocorrenciaRepo = sqlite.NewOcorrenciaRepository(db)
+		opcoesRouter = append(opcoesRouter, httpAdp.ComProntidao(cfg.Saude.Timeout,
+			httpAdp.Verificacao{Nome: "database", Verificar: db.PingContext},
+		))
+	default:
+		db, err := initializeDatabase(cfg.Database, logger)
+		if err != nil {
+			logger.Error("openning db conection", zap.Error(err))
+			return err
+		}
+		defer db.Close()
+		if cfg.Database.MigrarAoIniciar {
+			migrador, err := database.NewMigrador(db)
+			if err != nil {
+				return err
+			}
+			aplicadas, err := migrador.Subir(ctx)
+			if err != nil {
+				logger.Error("aplicando migrações", zap.Error(err))
+				return err
+			}
+			for _, migracao := range aplicadas {
+				logger.Info("migração aplicada", zap.Int("versao", migracao.Versao), zap.String("nome", migracao.Nome))
+			}
+		}
+		postgresRepo := database.NewPostgresRecebedorRepository(db, database.ComTimeouts(cfg.Database.TimeoutConsulta, cfg.Database.TimeoutEscrita))
+		webhookRepo := database.NewPostgresWebhookRepository(db)
+		webhookService := app.NewWebhookService(webhookRepo, logger)
+		if err := metricas.RegistrarDatabase(db, "postgres", postgresRepo); err != nil {
+			logger.Error("registrando métricas do banco de dados", zap.Error(err))
+			return err
+		}
+		publicador, err := inicializarPublicador(cfg.Eventos, webhookService)
+		if err != nil {
+			logger.Error("inicializando publicador de eventos", zap.Error(err))
+			return err
+		}
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			database.NewRelay(db, publicador, logger).Executar(ctx)
+		}()
+		go func() {
+			defer wg.Done()
+			webhook.NewDespachante(webhookRepo, logger).Executar(ctx)
+		}()
+		userRepo = postgresRepo
+		ocorrenciaRepo = database.NewPostgresOcorrenciaRepository(db)
+		if cfg.RateLimit.Backend == config.LimitadorPostgres {
+			limitador = database.NewLimitadorPostgres(db)
+		}
+		opcoesRouter = append(opcoesRouter,
+			httpAdp.ComWebhooks(webhookService),
+			httpAdp.ComProntidao(cfg.Saude.Timeout,
+				httpAdp.Verificacao{Nome: "database", Verificar: database.VerificarConexao(db)},
+				httpAdp.Verificacao{Nome: "migracoes", Verificar: database.VerificarMigracoes(db)},
+				httpAdp.Verificacao{Nome: "outbox", Verificar: database.VerificarAtrasoOutbox(db, cfg.Saude.MaxAtrasoOutbox)},
+			),
+		)
+	}
+	//o limite de requisições é a primeira opção para valer também para as rotas das demais opções
+	if cfg.RateLimit.Backend != config.LimitadorNenhum {
+		opcoesRouter = append([]httpAdp.OpcaoRouter{httpAdp.ComLimiteRequisicoes(limitador, httpAdp.LimitesRequisicoes{
+			Janela:           cfg.RateLimit.Janela,
+			Leitura:          cfg.RateLimit.Leitura,
+			Escrita:          cfg.RateLimit.Escrita,
+			Lote:             cfg.RateLimit.Lote,
+			CabecalhoCliente: cfg.RateLimit.CabecalhoCliente,
+		})}, opcoesRouter...)
+	}
+	if cfg.Http.ProxiesConfiaveis != "" {
+		opcoesRouter = append(opcoesRouter, httpAdp.ComProxiesConfiaveis(strings.Split(cfg.Http.ProxiesConfiaveis, ",")))
+	}
+	//os repositórios mantêm as chaves deletadas, o cache não implementa o histórico
+	historico, _ := userRepo.(domain.HistoricoChaves)
+	if cfg.Cache.Capacidade > 0 {
+		userRepo = cache.NewRecebedorRepository(userRepo, cache.NewLRU(cfg.Cache.Capacidade), cfg.Cache.Ttl)
+	}
+	opcoesRecebedor := []app.Opcao{app.ComTamanhoPagina(cfg.Paginacao.TamanhoPagina), app.ComCidadeBrCode(cfg.BrCode.Cidade)}
+	if cfg.Triagem.Listas != "" {
+		fonte := listarestritiva.NewArquivos(strings.Split(cfg.Triagem.Listas, ",")...)
+		triagemService := app.NewTriagemService(ocorrenciaRepo, userRepo, fonte, logger,
+			app.ComLimiarNome(cfg.Triagem.LimiarNome), app.ComIntervaloTriagem(cfg.Triagem.Intervalo),
+			app.ComRegistroErrosTriagem(metricas.RegistrarErroTarefa))
+		//sem as listas os recebedores seriam criados sem a triagem
+		if err := triagemService.Carregar(ctx); err != nil {
+			logger.Error("carregando listas restritivas", zap.Error(err))
+			return err
+		}
+		opcoesRecebedor = append(opcoesRecebedor, app.ComTriagem(triagemService))
+		opcoesRouter = append(opcoesRouter, httpAdp.ComTriagem(triagemService))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			triagemService.Executar(ctx)
+		}()
+	}
+	mailer, err := inicializarMailer(cfg.Email)
+	if err != nil {
+		logger.Error("inicializando mailer", zap.Error(err))
+		return err
+	}
+	if mailer != nil {
+		segredo := []byte(cfg.Email.Segredo)
+		if len(segredo) == 0 {
+			//os tokens enviados deixam de valer quando a aplicação é reiniciada
+			segredo = make([]byte, 32)
+			if _, err := rand.Read(segredo); err != nil {
+				return err
+			}
+			logger.Warn("EMAIL_VERIFICACAO_SEGREDO não informado, usando um segredo aleatório")
+		}
+		verificacaoService := app.NewVerificacaoEmailService(userRepo, mailer, segredo, logger,
+			app.ComValidadeToken(cfg.Email.ValidadeToken), app.ComUrlVerificacao(cfg.Email.UrlVerificacao),
+			app.ComRegistroErrosEmail(metricas.RegistrarErroTarefa))
+		opcoesRecebedor = append(opcoesRecebedor, app.ComVerificacaoEmail(verificacaoService))
+		opcoesRouter = append(opcoesRouter, httpAdp.ComVerificacaoEmail(verificacaoService))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			verificacaoService.Executar(ctx)
+		}()
+	}
+	recebedorService := app.NewRecebedorService(userRepo, logger, opcoesRecebedor...)
+	opcoesSuspeito := []app.OpcaoSuspeito{app.ComIntervaloDeteccao(cfg.Suspeitos.Intervalo),
+		app.ComRegistroErrosDeteccao(metricas.RegistrarErroTarefa)}
+	if cfg.Suspeitos.DominiosDescartaveis != "" {
+		opcoesSuspeito = append(opcoesSuspeito, app.ComDominiosDescartaveis(strings.Split(cfg.Suspeitos.DominiosDescartaveis, ",")))
+	}
+	suspeitoService := app.NewSuspeitoService(userRepo, historico, logger, opcoesSuspeito...)
+	opcoesRouter = append(opcoesRouter, httpAdp.ComSuspeitos(suspeitoService))
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		suspeitoService.Executar(ctx)
+	}()
+
+	server := &nethttp.Server{
+		Addr:         cfg.Http.Endereco,
+		Handler:      httpAdp.NewRouter(recebedorService, logger, opcoesRouter...),
+		ReadTimeout:  cfg.Http.ReadTimeout,
+		WriteTimeout: cfg.Http.WriteTimeout,
+		IdleTimeout:  cfg.Http.IdleTimeout,
+	}
+	erroGrpc := make(chan error, 1)
+	if cfg.Grpc.Endereco != "" {
+		grpcServer := grpcAdp.NewServer(recebedorService, logger)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := servirGrpc(ctx, grpcServer, cfg.Grpc.Endereco, cfg.Http.ShutdownTimeout, logger); err != nil {
+				erroGrpc <- err
+				//sem a api gRPC a aplicação é encerrada para ser reiniciada pelo orquestrador
+				stop()
+			}
+		}()
+	}
+	err = servir(ctx, server, cfg.Http, logger)
+	stop()
+	wg.Wait()
+	if err == nil {
+		select {
+		case err = <-erroGrpc:
+		default:
+		}
+	}
+	if err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
+		logger.Error("executando servidor", zap.Error(err))
+		return err
+	}
+	logger.Info("servidor encerrado")
+	return nil
+}
+
+// atende as chamadas gRPC até o contexto ser cancelado, quando aguarda as chamadas em
+// andamento por até o timeout informado antes de fechar as conexões
+func servirGrpc(ctx context.Context, server *grpc.Server, endereco string, timeout time.Duration, logger *zap.Logger) error {
+	listener, err := net.Listen("tcp", endereco)
 	if err != nil {
-		logger.Error("openning db conection", zap.Error(err))
 		return err
 	}
-	userRepo := database.NewPostgresRecebedorRepository(db)
-	recebedorService := app.NewRecebedorService(userRepo, logger)
-	server := http.NewRouter(recebedorService, logger)
-	server.Run(":8080")
+	erros := make(chan error, 1)
+	go func() {
+		logger.Info("servidor grpc iniciado", zap.String("endereco", endereco))
+		erros <- server.Serve(listener)
+	}()
+	select {
+	case err := <-erros:
+		return err
+	case <-ctx.Done():
+	}
+	logger.Info("encerrando servidor grpc")
+	encerrado := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(encerrado)
+	}()
+	select {
+	case <-encerrado:
+	case <-time.After(timeout):
+		server.Stop()
+	}
 	return nil
 }
 
-func main() {
+// executa o subcomando informado após as flags, atualmente apenas migrate up|down|status
+func executarComando(cfg *config.Config, args []string) error {
+	if len(args) != 2 || args[0] != "migrate" {
+		return fmt.Errorf("comando desconhecido %q, uso: api [flags] migrate up|down|status", strings.Join(args, " "))
+	}
+	logger := inicializarLog(cfg)
+	defer logger.Sync()
+	db, err := initializeDatabase(cfg.Database, logger)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	migrador, err := database.NewMigrador(db)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	switch args[1] {
+	case "up":
+		aplicadas, err := migrador.Subir(ctx)
+		for _, migracao := range aplicadas {
+			fmt.Printf("aplicada %04d_%s\n", migracao.Versao, migracao.Nome)
+		}
+		if err == nil && len(aplicadas) == 0 {
+			fmt.Println("nenhuma migração pendente")
+		}
+		return err
+	case "down":
+		revertida, err := migrador.Descer(ctx)
+		if revertida != nil {
+			fmt.Printf("revertida %04d_%s\n", revertida.Versao, revertida.Nome)
+		} else if err == nil {
+			fmt.Println("nenhuma migração aplicada")
+		}
+		return err
+	case "status":
+		status, err := migrador.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			aplicadaEm := "pendente"
+			if s.AplicadaEm != nil {
+				aplicadaEm = s.AplicadaEm.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%s\t%s\n", s.Versao, s.Nome, aplicadaEm)
+		}
+		return nil
+	}
+	return fmt.Errorf("subcomando desconhecido %q, uso: api [flags] migrate up|down|status", args[1])
+}
 
-	if err := run(); err != nil {
-		log.Fatalf("iniciando servidor" + err.Error())
+func main() {
+	cfg, opcoes, err := config.Carregar(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		log.Fatalf("configuração inválida:\n%v", err)
+	}
+	if opcoes.ImprimirConfig {
+		if err := cfg.Imprimir(os.Stdout); err != nil {
+			log.Fatalf("imprimindo configuração: %v", err)
+		}
+		return
+	}
+	if len(opcoes.Argumentos) > 0 {
+		if err := executarComando(cfg, opcoes.Argumentos); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+	if err := run(cfg); err != nil {
+		log.Fatalf("iniciando servidor: %v", err)
 	}
-	os.Exit(1)
 }
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// campos das buscas de recebedores
+const (
+	FiltroNome      = "nome"
+	FiltroChave     = "chave"
+	FiltroStatus    = "status"
+	FiltroTipoChave = "tipo-chave"
+)
+
+type Filtro struct {
+	Campo string
+	Valor string
+	//tipo da chave consultada no filtro por chave, opcional
+	TipoChave string
+}
+
+// Cliente executa as operações de recebedores pela api HTTP ou diretamente pelo RecebedorService
+type Cliente interface {
+	// cria o recebedor, o id é preenchido apenas quando informado pela origem
+	Criar(ctx context.Context, recebedor *domain.Recebedor) error
+	BuscarPorId(ctx context.Context, id uint) (*domain.Recebedor, error)
+	Buscar(ctx context.Context, filtro Filtro, pagina int) (*domain.PaginaRecebedores, error)
+	Editar(ctx context.Context, recebedor *domain.Recebedor) error
+	EditarEmail(ctx context.Context, id uint, email string) error
+	Validar(ctx context.Context, id uint) error
+	Deletar(ctx context.Context, id uint) error
+	// retorna domain.ErrRecebedoresNaoDeletados se algum recebedor não foi deletado
+	DeletarEmLote(ctx context.Context, ids []uint) error
+}
+
+// acessa o banco de dados pelo mesmo serviço usado pela api
+type clienteServico struct {
+	service *app.RecebedorService
+}
+
+func (c *clienteServico) Criar(ctx context.Context, recebedor *domain.Recebedor) error {
+	return c.service.CriarRecebedor(ctx, recebedor)
+}
+
+func (c *clienteServico) BuscarPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	return c.service.BuscarRecebedorById(ctx, id)
+}
+
+func (c *clienteServico) Buscar(ctx context.Context, filtro Filtro, pagina int) (*domain.PaginaRecebedores, error) {
+	switch filtro.Campo {
+	case FiltroNome:
+		return c.service.BuscarRecebedoresPorNome(ctx, filtro.Valor, pagina)
+	case FiltroChave:
+		return c.service.BuscarRecebedoresPorChave(ctx, filtro.Valor, filtro.TipoChave, pagina)
+	case FiltroStatus:
+		return c.service.BuscarRecebedoresPorStatus(ctx, filtro.Valor, pagina)
+	case FiltroTipoChave:
+		return c.service.BuscarRecebedoresPorTipoChavePix(ctx, filtro.Valor, pagina)
+	default:
+		return nil, fmt.Errorf("filtro desconhecido: %q", filtro.Campo)
+	}
+}
+
+func (c *clienteServico) Editar(ctx context.Context, recebedor *domain.Recebedor) error {
+	return c.service.EditarRecebedor(ctx, recebedor)
+}
+
+func (c *clienteServico) EditarEmail(ctx context.Context, id uint, email string) error {
+	return c.service.EditarEmailRecebedor(ctx, id, email)
+}
+
+func (c *clienteServico) Validar(ctx context.Context, id uint) error {
+	return c.service.ValidarRecebedor(ctx, id)
+}
+
+func (c *clienteServico) Deletar(ctx context.Context, id uint) error {
+	return c.service.DeletarRecebedor(ctx, id)
+}
+
+func (c *clienteServico) DeletarEmLote(ctx context.Context, ids []uint) error {
+	return c.service.DeletarRecebedores(ctx, ids)
+}
+
+// percorre todas as páginas do filtro
+func percorrer(ctx context.Context, cliente Cliente, filtro Filtro, fn func(*domain.Recebedor) error) error {
+	for pagina := 1; ; pagina++ {
+		resultado, err := cliente.Buscar(ctx, filtro, pagina)
+		if err != nil {
+			return err
+		}
+		for _, recebedor := range resultado.Recebedores {
+			if err := fn(recebedor); err != nil {
+				return err
+			}
+		}
+		if pagina >= resultado.TotalPaginas {
+			return nil
+		}
+	}
+}
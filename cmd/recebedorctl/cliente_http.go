@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// ErroApi é uma resposta de erro da api HTTP
+type ErroApi struct {
+	Status   int
+	Mensagem string
+}
+
+func (e *ErroApi) Error() string {
+	return fmt.Sprintf("%s (http %d)", e.Mensagem, e.Status)
+}
+
+const (
+	//identifica a ferramenta no limite de requisições da api
+	identificadorCliente = "recebedorctl"
+
+	//tentativas de uma requisição recusada pelo limite de requisições
+	tentativasLimite = 5
+)
+
+// acessa a api HTTP, os caminhos são relativos a url base informada
+type clienteHttp struct {
+	url  string
+	http *http.Client
+}
+
+func NewClienteHttp(base string, httpClient *http.Client) *clienteHttp {
+	return &clienteHttp{url: strings.TrimSuffix(base, "/") + "/api/v1", http: httpClient}
+}
+
+// executa a requisição e decodifica a resposta de sucesso em resposta, se informada.
+// Requisições recusadas pelo limite de requisições são repetidas após o Retry-After
+func (c *clienteHttp) requisitar(ctx context.Context, metodo, caminho string, corpo, resposta any) error {
+	var conteudoCorpo []byte
+	if corpo != nil {
+		var err error
+		if conteudoCorpo, err = json.Marshal(corpo); err != nil {
+			return err
+		}
+	}
+	for tentativa := 1; ; tentativa++ {
+		req, err := http.NewRequestWithContext(ctx, metodo, c.url+caminho, bytes.NewReader(conteudoCorpo))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", identificadorCliente)
+		req.Header.Set("X-Client-Id", identificadorCliente)
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return err
+		}
+		conteudo, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode == http.StatusTooManyRequests && tentativa < tentativasLimite {
+			espera, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(max(espera, 1)) * time.Second):
+			}
+			continue
+		}
+		return decodificar(resp.StatusCode, conteudo, resposta)
+	}
+}
+
+func decodificar(status int, conteudo []byte, resposta any) error {
+	if status == http.StatusMultiStatus {
+		return erroDeletarEmLote(conteudo)
+	}
+	if status >= 300 {
+		return &ErroApi{Status: status, Mensagem: mensagemErro(conteudo)}
+	}
+	if resposta == nil || len(conteudo) == 0 {
+		return nil
+	}
+	return json.Unmarshal(conteudo, resposta)
+}
+
+// extrai a mensagem dos formatos de erro da api (message, error e campos obrigatórios)
+func mensagemErro(conteudo []byte) string {
+	var corpo struct {
+		Message any    `json:"message"`
+		Error   string `json:"error"`
+		Campos  []struct {
+			Campo string `json:"campo"`
+		} `json:"campos"`
+	}
+	if err := json.Unmarshal(conteudo, &corpo); err != nil {
+		return strings.TrimSpace(string(conteudo))
+	}
+	mensagem := corpo.Error
+	if texto, ok := corpo.Message.(string); ok {
+		mensagem = texto
+	}
+	campos := []string{}
+	for _, campo := range corpo.Campos {
+		campos = append(campos, campo.Campo)
+	}
+	if len(campos) > 0 {
+		mensagem += ": " + strings.Join(campos, ", ")
+	}
+	return mensagem
+}
+
+func erroDeletarEmLote(conteudo []byte) error {
+	var corpo struct {
+		Message domain.ErrRecebedoresNaoDeletados `json:"message"`
+	}
+	if err := json.Unmarshal(conteudo, &corpo); err != nil {
+		return err
+	}
+	return corpo.Message
+}
+
+func (c *clienteHttp) Criar(ctx context.Context, recebedor *domain.Recebedor) error {
+	return c.requisitar(ctx, http.MethodPost, "/recebedores", recebedor, nil)
+}
+
+func (c *clienteHttp) BuscarPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	var recebedor domain.Recebedor
+	if err := c.requisitar(ctx, http.MethodGet, fmt.Sprintf("/recebedores/id/%d", id), nil, &recebedor); err != nil {
+		return nil, err
+	}
+	return &recebedor, nil
+}
+
+func (c *clienteHttp) Buscar(ctx context.Context, filtro Filtro, pagina int) (*domain.PaginaRecebedores, error) {
+	parametros := url.Values{"pagina": {strconv.Itoa(pagina)}}
+	var caminho string
+	switch filtro.Campo {
+	case FiltroNome:
+		caminho = "/recebedores/nome/" + url.PathEscape(filtro.Valor)
+	case FiltroStatus:
+		caminho = "/recebedores/status/" + url.PathEscape(filtro.Valor)
+	case FiltroTipoChave:
+		caminho = "/recebedores/tipoChave/" + url.PathEscape(filtro.Valor)
+	case FiltroChave:
+		caminho = "/recebedores/chave"
+		parametros.Set("chave", filtro.Valor)
+		if filtro.TipoChave != "" {
+			parametros.Set("tipo", filtro.TipoChave)
+		}
+	default:
+		return nil, fmt.Errorf("filtro desconhecido: %q", filtro.Campo)
+	}
+	var resultado domain.PaginaRecebedores
+	if err := c.requisitar(ctx, http.MethodGet, caminho+"?"+parametros.Encode(), nil, &resultado); err != nil {
+		return nil, err
+	}
+	return &resultado, nil
+}
+
+func (c *clienteHttp) Editar(ctx context.Context, recebedor *domain.Recebedor) error {
+	return c.requisitar(ctx, http.MethodPatch, "/recebedores", recebedor, nil)
+}
+
+func (c *clienteHttp) EditarEmail(ctx context.Context, id uint, email string) error {
+	return c.requisitar(ctx, http.MethodPatch, fmt.Sprintf("/recebedores/%d", id), map[string]string{"email": email}, nil)
+}
+
+func (c *clienteHttp) Validar(ctx context.Context, id uint) error {
+	return c.requisitar(ctx, http.MethodPatch, fmt.Sprintf("/recebedores/%d/validar", id), nil, nil)
+}
+
+func (c *clienteHttp) Deletar(ctx context.Context, id uint) error {
+	return c.requisitar(ctx, http.MethodDelete, fmt.Sprintf("/recebedores/%d", id), nil, nil)
+}
+
+func (c *clienteHttp) DeletarEmLote(ctx context.Context, ids []uint) error {
+	return c.requisitar(ctx, http.MethodDelete, "/recebedores/deletar", map[string][]uint{"ids": ids}, nil)
+}
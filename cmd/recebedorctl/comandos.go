@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// errUso indica argumentos inválidos, a mensagem de uso já foi exibida
+var errUso = errors.New("uso inválido")
+
+type comandos struct {
+	cliente Cliente
+	saida   *saida
+	erros   io.Writer
+}
+
+func (c *comandos) flags(nome string) *flag.FlagSet {
+	fs := flag.NewFlagSet(nome, flag.ContinueOnError)
+	fs.SetOutput(c.erros)
+	return fs
+}
+
+// executa o comando, o primeiro argumento é o nome do comando
+func (c *comandos) executar(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(c.erros, uso)
+		return errUso
+	}
+	execucoes := map[string]func(context.Context, []string) error{
+		"criar":    c.criar,
+		"buscar":   c.buscar,
+		"editar":   c.editar,
+		"validar":  c.validar,
+		"deletar":  c.deletar,
+		"importar": c.importar,
+		"exportar": c.exportar,
+	}
+	execucao, ok := execucoes[args[0]]
+	if !ok {
+		fmt.Fprintf(c.erros, "comando desconhecido %q\n\n%s", args[0], uso)
+		return errUso
+	}
+	return execucao(ctx, args[1:])
+}
+
+// campos do recebedor informados por flags, usados pelo criar e pelo editar
+type camposRecebedor struct {
+	nome, cpfCnpj, tipoChave, chave, email *string
+}
+
+func registrarCampos(fs *flag.FlagSet) camposRecebedor {
+	return camposRecebedor{
+		nome:      fs.String("nome", "", "nome do recebedor"),
+		cpfCnpj:   fs.String("cpf-cnpj", "", "cpf ou cnpj do recebedor"),
+		tipoChave: fs.String("tipo-chave", "", "tipo da chave pix (CPF, CNPJ, EMAIL, TELEFONE ou CHAVE_ALEATORIA)"),
+		chave:     fs.String("chave", "", "chave pix"),
+		email:     fs.String("email", "", "email do recebedor"),
+	}
+}
+
+func (c *comandos) criar(ctx context.Context, args []string) error {
+	fs := c.flags("criar")
+	campos := registrarCampos(fs)
+	if err := fs.Parse(args); err != nil {
+		return errUso
+	}
+	recebedor := &domain.Recebedor{
+		Nome:         *campos.nome,
+		CpfCnpj:      *campos.cpfCnpj,
+		TipoChavePix: domain.TipoChavePix(*campos.tipoChave),
+		ChavePix:     *campos.chave,
+		Email:        *campos.email,
+	}
+	if err := c.cliente.Criar(ctx, recebedor); err != nil {
+		return err
+	}
+	//a api HTTP não retorna o id do recebedor criado
+	if recebedor.Id == 0 {
+		return c.saida.Mensagem("recebedor criado")
+	}
+	return c.saida.Recebedor(recebedor)
+}
+
+// filtro informado pelas flags, no máximo um dos campos pode ser informado
+type flagsFiltro struct {
+	nome, chave, tipo, status, tipoChave *string
+}
+
+func registrarFiltro(fs *flag.FlagSet) flagsFiltro {
+	return flagsFiltro{
+		nome:      fs.String("nome", "", "busca pelo nome"),
+		chave:     fs.String("chave", "", "busca pela chave pix"),
+		tipo:      fs.String("tipo", "", "tipo da chave consultada com --chave, opcional"),
+		status:    fs.String("status", "", "busca pelo status (Rascunho, Validado ou Bloqueado)"),
+		tipoChave: fs.String("tipo-chave", "", "busca pelo tipo de chave pix"),
+	}
+}
+
+// retorna os filtros informados, vazio se nenhum filtro foi informado
+func (f flagsFiltro) filtros() []Filtro {
+	filtros := []Filtro{}
+	for campo, valor := range map[string]string{FiltroNome: *f.nome, FiltroChave: *f.chave, FiltroStatus: *f.status, FiltroTipoChave: *f.tipoChave} {
+		if valor != "" {
+			filtros = append(filtros, Filtro{Campo: campo, Valor: valor, TipoChave: *f.tipo})
+		}
+	}
+	return filtros
+}
+
+func (c *comandos) buscar(ctx context.Context, args []string) error {
+	fs := c.flags("buscar")
+	id := fs.Uint("id", 0, "busca pelo id")
+	filtro := registrarFiltro(fs)
+	pagina := fs.Int("pagina", 1, "página da busca")
+	todas := fs.Bool("todas", false, "busca todas as páginas")
+	if err := fs.Parse(args); err != nil {
+		return errUso
+	}
+	filtros := filtro.filtros()
+	if *id != 0 {
+		if len(filtros) > 0 {
+			return fmt.Errorf("--id não pode ser combinado com outros filtros")
+		}
+		recebedor, err := c.cliente.BuscarPorId(ctx, *id)
+		if err != nil {
+			return err
+		}
+		return c.saida.Recebedor(recebedor)
+	}
+	if len(filtros) != 1 {
+		return fmt.Errorf("informe um filtro: --id, --nome, --chave, --status ou --tipo-chave")
+	}
+	if *todas {
+		recebedores := []*domain.Recebedor{}
+		err := percorrer(ctx, c.cliente, filtros[0], func(r *domain.Recebedor) error {
+			recebedores = append(recebedores, r)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return c.saida.Recebedores(recebedores)
+	}
+	resultado, err := c.cliente.Buscar(ctx, filtros[0], *pagina)
+	if err != nil {
+		return err
+	}
+	return c.saida.Pagina(resultado)
+}
+
+// altera apenas os campos informados, o recebedor atual é consultado pois o serviço
+// valida o recebedor completo. Informando apenas o email é usada a edição de email,
+// permitida também para recebedores validados
+func (c *comandos) editar(ctx context.Context, args []string) error {
+	fs := c.flags("editar")
+	id := fs.Uint("id", 0, "id do recebedor")
+	campos := registrarCampos(fs)
+	if err := fs.Parse(args); err != nil {
+		return errUso
+	}
+	if *id == 0 {
+		return fmt.Errorf("--id é obrigatório")
+	}
+	informados := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { informados[f.Name] = true })
+	delete(informados, "id")
+	if len(informados) == 0 {
+		return fmt.Errorf("informe ao menos um campo para editar")
+	}
+	if len(informados) == 1 && informados["email"] {
+		if err := c.cliente.EditarEmail(ctx, *id, *campos.email); err != nil {
+			return err
+		}
+	} else {
+		recebedor, err := c.cliente.BuscarPorId(ctx, *id)
+		if err != nil {
+			return err
+		}
+		substituir := map[string]func(){
+			"nome":       func() { recebedor.Nome = *campos.nome },
+			"cpf-cnpj":   func() { recebedor.CpfCnpj = *campos.cpfCnpj },
+			"tipo-chave": func() { recebedor.TipoChavePix = domain.TipoChavePix(*campos.tipoChave) },
+			"chave":      func() { recebedor.ChavePix = *campos.chave },
+			"email":      func() { recebedor.Email = *campos.email },
+		}
+		for campo := range informados {
+			substituir[campo]()
+		}
+		recebedor.ChavePixFormatada, recebedor.TipoCorrespondente = "", ""
+		if err := c.cliente.Editar(ctx, recebedor); err != nil {
+			return err
+		}
+	}
+	editado, err := c.cliente.BuscarPorId(ctx, *id)
+	if err != nil {
+		return err
+	}
+	return c.saida.Recebedor(editado)
+}
+
+func lerIds(args []string) ([]uint, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("informe ao menos um id")
+	}
+	ids := make([]uint, len(args))
+	for i, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 0)
+		if err != nil {
+			return nil, fmt.Errorf("id inválido: %q", arg)
+		}
+		ids[i] = uint(id)
+	}
+	return ids, nil
+}
+
+func (c *comandos) validar(ctx context.Context, args []string) error {
+	ids, err := lerIds(args)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := c.cliente.Validar(ctx, id); err != nil {
+			return fmt.Errorf("validando recebedor %d: %w", id, err)
+		}
+	}
+	return c.saida.Mensagem("%d recebedores validados", len(ids))
+}
+
+func (c *comandos) deletar(ctx context.Context, args []string) error {
+	ids, err := lerIds(args)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 1 {
+		if err := c.cliente.Deletar(ctx, ids[0]); err != nil {
+			return err
+		}
+		return c.saida.Mensagem("recebedor %d deletado", ids[0])
+	}
+	err = c.cliente.DeletarEmLote(ctx, ids)
+	var naoDeletados domain.ErrRecebedoresNaoDeletados
+	if errors.As(err, &naoDeletados) {
+		return fmt.Errorf("recebedores deletados: %v, não deletados: %v", naoDeletados.IdsComSucesso, naoDeletados.IdsSemSucesso)
+	}
+	if err != nil {
+		return err
+	}
+	return c.saida.Mensagem("%d recebedores deletados", len(ids))
+}
+
+func (c *comandos) importar(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("uso: recebedorctl importar arquivo.csv (- para a entrada padrão)")
+	}
+	arquivo := os.Stdin
+	if args[0] != "-" {
+		var err error
+		if arquivo, err = os.Open(args[0]); err != nil {
+			return err
+		}
+		defer arquivo.Close()
+	}
+	recebedores, err := lerCsv(arquivo)
+	if err != nil {
+		return err
+	}
+	importados, erros := importar(ctx, c.cliente, recebedores)
+	for _, erro := range erros {
+		fmt.Fprintln(c.erros, erro)
+	}
+	if err := c.saida.Mensagem("%d de %d recebedores importados", importados, len(recebedores)); err != nil {
+		return err
+	}
+	if len(erros) > 0 {
+		return fmt.Errorf("%d recebedores não importados", len(erros))
+	}
+	return nil
+}
+
+// exporta os recebedores do filtro, sem filtro são exportados todos os recebedores
+// percorrendo todos os status. O CSV é escrito à medida que as páginas são consultadas
+func (c *comandos) exportar(ctx context.Context, args []string) error {
+	fs := c.flags("exportar")
+	filtro := registrarFiltro(fs)
+	caminho := fs.String("arquivo", "", "arquivo de destino, por padrão a saída padrão")
+	if err := fs.Parse(args); err != nil {
+		return errUso
+	}
+	filtros := filtro.filtros()
+	if len(filtros) > 1 {
+		return fmt.Errorf("informe no máximo um filtro")
+	}
+	if len(filtros) == 0 {
+		filtros = []Filtro{{Campo: FiltroStatus, Valor: domain.StatusRascunho}, {Campo: FiltroStatus, Valor: domain.StatusValidado}, {Campo: FiltroStatus, Valor: domain.StatusBloqueado}}
+	}
+	destino := c.saida.w
+	if *caminho != "" {
+		arquivo, err := os.Create(*caminho)
+		if err != nil {
+			return err
+		}
+		defer arquivo.Close()
+		destino = arquivo
+	}
+	if c.saida.formato == SaidaJson {
+		recebedores := []*domain.Recebedor{}
+		for _, filtro := range filtros {
+			err := percorrer(ctx, c.cliente, filtro, func(r *domain.Recebedor) error {
+				recebedores = append(recebedores, r)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return (&saida{formato: SaidaJson, w: destino}).Recebedores(recebedores)
+	}
+	escritor, err := novoEscritorCsv(destino)
+	if err != nil {
+		return err
+	}
+	for _, filtro := range filtros {
+		if err := percorrer(ctx, c.cliente, filtro, func(r *domain.Recebedor) error { return escreverCsv(escritor, r) }); err != nil {
+			return err
+		}
+	}
+	escritor.Flush()
+	return escritor.Error()
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// colunas do arquivo exportado, o importar aceita as mesmas colunas em qualquer ordem
+var colunasCsv = []string{"id", "cpf_cnpj", "nome", "tipo_chave_pix", "chave_pix", "status", "email"}
+
+// colunas obrigatórias para importar, id e status são ignorados pois são definidos pela api
+var colunasObrigatorias = []string{"cpf_cnpj", "nome", "tipo_chave_pix", "chave_pix"}
+
+// ErroLinha é um recebedor do arquivo que não foi importado
+type ErroLinha struct {
+	Linha int
+	Err   error
+}
+
+func (e ErroLinha) Error() string {
+	return fmt.Sprintf("linha %d: %v", e.Linha, e.Err)
+}
+
+// lê os recebedores do CSV com cabeçalho, retornando erro se faltar uma coluna obrigatória
+func lerCsv(r io.Reader) ([]*domain.Recebedor, error) {
+	leitor := csv.NewReader(r)
+	leitor.TrimLeadingSpace = true
+	cabecalho, err := leitor.Read()
+	if err != nil {
+		return nil, fmt.Errorf("lendo cabeçalho: %w", err)
+	}
+	indices := map[string]int{}
+	for i, coluna := range cabecalho {
+		indices[strings.ToLower(strings.TrimSpace(coluna))] = i
+	}
+	for _, coluna := range colunasObrigatorias {
+		if _, ok := indices[coluna]; !ok {
+			return nil, fmt.Errorf("coluna obrigatória ausente: %s", coluna)
+		}
+	}
+	valor := func(registro []string, coluna string) string {
+		if i, ok := indices[coluna]; ok && i < len(registro) {
+			return strings.TrimSpace(registro[i])
+		}
+		return ""
+	}
+	recebedores := []*domain.Recebedor{}
+	for {
+		registro, err := leitor.Read()
+		if errors.Is(err, io.EOF) {
+			return recebedores, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		recebedores = append(recebedores, &domain.Recebedor{
+			CpfCnpj:      valor(registro, "cpf_cnpj"),
+			Nome:         valor(registro, "nome"),
+			TipoChavePix: domain.TipoChavePix(strings.ToUpper(valor(registro, "tipo_chave_pix"))),
+			ChavePix:     valor(registro, "chave_pix"),
+			Email:        valor(registro, "email"),
+		})
+	}
+}
+
+// cria os recebedores do arquivo, um recebedor inválido não interrompe a importação
+// e os recebedores não importados são retornados com a linha do arquivo
+func importar(ctx context.Context, cliente Cliente, recebedores []*domain.Recebedor) (int, []ErroLinha) {
+	importados := 0
+	erros := []ErroLinha{}
+	for i, recebedor := range recebedores {
+		if err := cliente.Criar(ctx, recebedor); err != nil {
+			//a primeira linha é o cabeçalho
+			erros = append(erros, ErroLinha{Linha: i + 2, Err: err})
+			continue
+		}
+		importados++
+	}
+	return importados, erros
+}
+
+func novoEscritorCsv(w io.Writer) (*csv.Writer, error) {
+	escritor := csv.NewWriter(w)
+	return escritor, escritor.Write(colunasCsv)
+}
+
+func escreverCsv(escritor *csv.Writer, r *domain.Recebedor) error {
+	return escritor.Write([]string{strconv.FormatUint(uint64(r.Id), 10), r.CpfCnpj, r.Nome, string(r.TipoChavePix), r.ChavePix, r.Status, r.Email})
+}
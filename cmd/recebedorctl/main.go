@@ -0,0 +1,140 @@
+// recebedorctl é a ferramenta de linha de comando para administrar os recebedores, pela
+// api HTTP ou diretamente pelo banco de dados usando o RecebedorService
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/config"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/database"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/listarestritiva"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/sqlite"
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const uso = `uso: recebedorctl [flags] comando [argumentos]
+
+comandos:
+  criar --nome --cpf-cnpj --tipo-chave --chave [--email]
+  buscar --id | --nome | --chave [--tipo] | --status | --tipo-chave [--pagina N] [--todas]
+  editar --id [--nome] [--cpf-cnpj] [--tipo-chave] [--chave] [--email]
+  validar id...
+  deletar id...
+  importar arquivo.csv
+  exportar [--nome | --chave | --status | --tipo-chave] [--arquivo destino.csv]
+
+flags:
+  --api url       url da api HTTP (RECEBEDORCTL_API, padrão http://localhost:8080)
+  --direto        acessa o banco de dados com a configuração da api (DATABASE_*, REPOSITORIO)
+  --saida formato tabela ou json (padrão tabela)
+`
+
+const timeoutRequisicao = 30 * time.Second
+
+// conecta ao banco de dados da api pelas mesmas variáveis de ambiente e arquivo de configuração
+func conectarDireto(ctx context.Context) (Cliente, func() error, error) {
+	cfg, _, err := config.Carregar(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("configuração inválida: %w", err)
+	}
+	var repo domain.RecebedorRepository
+	var ocorrencias domain.OcorrenciaRepository
+	var db *sql.DB
+	switch cfg.Repositorio {
+	case config.RepositorioPostgres:
+		if db, err = sql.Open("postgres", cfg.Database.DatabaseUri()); err != nil {
+			return nil, nil, err
+		}
+		if err := db.PingContext(ctx); err != nil {
+			db.Close()
+			return nil, nil, fmt.Errorf("conectando ao banco de dados: %w", err)
+		}
+		repo = database.NewPostgresRecebedorRepository(db, database.ComTimeouts(cfg.Database.TimeoutConsulta, cfg.Database.TimeoutEscrita))
+		ocorrencias = database.NewPostgresOcorrenciaRepository(db)
+	case config.RepositorioSqlite:
+		if db, err = sqlite.Abrir(ctx, cfg.Sqlite.Arquivo); err != nil {
+			return nil, nil, err
+		}
+		repo = sqlite.NewRecebedorRepository(db)
+		ocorrencias = sqlite.NewOcorrenciaRepository(db)
+	default:
+		return nil, nil, fmt.Errorf("o repositório %s não é compartilhado com a api, use --api", cfg.Repositorio)
+	}
+	opcoes := []app.Opcao{app.ComTamanhoPagina(cfg.Paginacao.TamanhoPagina), app.ComCidadeBrCode(cfg.BrCode.Cidade)}
+	//os recebedores criados e editados passam pela mesma triagem das listas restritivas da api
+	if cfg.Triagem.Listas != "" {
+		fonte := listarestritiva.NewArquivos(strings.Split(cfg.Triagem.Listas, ",")...)
+		triagem := app.NewTriagemService(ocorrencias, repo, fonte, zap.NewNop(), app.ComLimiarNome(cfg.Triagem.LimiarNome))
+		if err := triagem.Carregar(ctx); err != nil {
+			db.Close()
+			return nil, nil, fmt.Errorf("carregando listas restritivas: %w", err)
+		}
+		opcoes = append(opcoes, app.ComTriagem(triagem))
+	}
+	service := app.NewRecebedorService(repo, zap.NewNop(), opcoes...)
+	return &clienteServico{service: service}, db.Close, nil
+}
+
+func executar(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("recebedorctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { fmt.Fprint(stderr, uso) }
+	padraoApi := os.Getenv("RECEBEDORCTL_API")
+	if padraoApi == "" {
+		padraoApi = "http://localhost:8080"
+	}
+	api := fs.String("api", padraoApi, "url da api HTTP")
+	direto := fs.Bool("direto", false, "acessa o banco de dados diretamente")
+	formato := fs.String("saida", SaidaTabela, "formato da saída")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if *formato != SaidaTabela && *formato != SaidaJson {
+		fmt.Fprintf(stderr, "formato de saída inválido: %q\n", *formato)
+		return 2
+	}
+
+	var cliente Cliente = NewClienteHttp(*api, &http.Client{Timeout: timeoutRequisicao})
+	if *direto {
+		servico, fechar, err := conectarDireto(ctx)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		defer fechar()
+		cliente = servico
+	}
+	c := &comandos{cliente: cliente, saida: &saida{formato: *formato, w: stdout}, erros: stderr}
+	if err := c.executar(ctx, fs.Args()); err != nil {
+		if errors.Is(err, errUso) {
+			return 2
+		}
+		fmt.Fprintln(stderr, "erro:", err)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	codigo := executar(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(codigo)
+}
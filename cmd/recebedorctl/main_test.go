@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	httpInfra "github.com/flaviorodolfo/transfeera-challenge/internal/infra/http"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const csvRecebedores = `nome,cpf_cnpj,tipo_chave_pix,chave_pix,email
+ana,783.852.830-56,email,ana@transfeera.com,ana@transfeera.com
+bruno,783.852.830-56,EMAIL,bruno@transfeera.com,
+,783.852.830-56,EMAIL,sem.nome@transfeera.com,
+carla,783.852.830-56,EMAIL,carla@transfeera.com,carla@transfeera.com
+`
+
+// executa os mesmos cenários pelo serviço e pela api HTTP
+func clientes(t *testing.T) map[string]Cliente {
+	novoService := func() *app.RecebedorService {
+		return app.NewRecebedorService(memoria.NewRecebedorRepository(), zap.NewNop(), app.ComTamanhoPagina(2))
+	}
+	gin.SetMode(gin.TestMode)
+	servidor := httptest.NewServer(httpInfra.NewRouter(novoService(), zap.NewNop()))
+	t.Cleanup(servidor.Close)
+	return map[string]Cliente{
+		"direto": &clienteServico{service: novoService()},
+		"http":   NewClienteHttp(servidor.URL, servidor.Client()),
+	}
+}
+
+func executarComando(t *testing.T, cliente Cliente, formato string, args ...string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	c := &comandos{cliente: cliente, saida: &saida{formato: formato, w: &stdout}, erros: &stderr}
+	err := c.executar(context.Background(), args)
+	return stdout.String(), stderr.String(), err
+}
+
+func TestComandos_ImportarBuscarExportar(t *testing.T) {
+	for nome, cliente := range clientes(t) {
+		t.Run(nome, func(t *testing.T) {
+			arquivo := filepath.Join(t.TempDir(), "recebedores.csv")
+			require.NoError(t, os.WriteFile(arquivo, []byte(csvRecebedores), 0o600))
+
+			stdout, stderr, err := executarComando(t, cliente, SaidaTabela, "importar", arquivo)
+			assert.EqualError(t, err, "1 recebedores não importados")
+			assert.Equal(t, "3 de 4 recebedores importados\n", stdout)
+			assert.True(t, strings.HasPrefix(stderr, "linha 4:"), stderr)
+
+			stdout, _, err = executarComando(t, cliente, SaidaJson, "buscar", "--status", domain.StatusRascunho, "--todas")
+			require.NoError(t, err)
+			var recebedores []domain.Recebedor
+			require.NoError(t, json.Unmarshal([]byte(stdout), &recebedores))
+			assert.Len(t, recebedores, 3)
+
+			stdout, _, err = executarComando(t, cliente, SaidaTabela, "buscar", "--nome", "ana")
+			require.NoError(t, err)
+			assert.Contains(t, stdout, "ana@transfeera.com")
+			assert.Contains(t, stdout, "página 1 de 1, 1 recebedores")
+
+			_, _, err = executarComando(t, cliente, SaidaTabela, "validar", "1")
+			require.NoError(t, err)
+			stdout, _, err = executarComando(t, cliente, SaidaTabela, "exportar")
+			require.NoError(t, err)
+			linhas := strings.Split(strings.TrimSpace(stdout), "\n")
+			assert.Equal(t, strings.Join(colunasCsv, ","), linhas[0])
+			assert.Len(t, linhas, 4)
+
+			//o arquivo exportado pode ser importado novamente
+			exportados, err := lerCsv(strings.NewReader(stdout))
+			require.NoError(t, err)
+			assert.Len(t, exportados, 3)
+		})
+	}
+}
+
+func TestComandos_EditarDeletar(t *testing.T) {
+	for nome, cliente := range clientes(t) {
+		t.Run(nome, func(t *testing.T) {
+			_, _, err := executarComando(t, cliente, SaidaTabela, "criar", "--nome", "ana", "--cpf-cnpj", "783.852.830-56",
+				"--tipo-chave", "EMAIL", "--chave", "ana@transfeera.com")
+			require.NoError(t, err)
+
+			stdout, _, err := executarComando(t, cliente, SaidaJson, "editar", "--id", "1", "--nome", "ana maria", "--chave", "ana.maria@transfeera.com")
+			require.NoError(t, err)
+			var editado domain.Recebedor
+			require.NoError(t, json.Unmarshal([]byte(stdout), &editado))
+			assert.Equal(t, "ana maria", editado.Nome)
+			assert.Equal(t, "ana.maria@transfeera.com", editado.ChavePix)
+			assert.Equal(t, "783.852.830-56", editado.CpfCnpj)
+
+			//recebedores validados permitem apenas a edição do email
+			_, _, err = executarComando(t, cliente, SaidaTabela, "validar", "1")
+			require.NoError(t, err)
+			_, _, err = executarComando(t, cliente, SaidaTabela, "editar", "--id", "1", "--email", "nova@transfeera.com")
+			assert.NoError(t, err)
+			_, _, err = executarComando(t, cliente, SaidaTabela, "editar", "--id", "1", "--nome", "outro")
+			assert.Error(t, err)
+
+			_, _, err = executarComando(t, cliente, SaidaTabela, "deletar", "1", "99")
+			assert.ErrorContains(t, err, "não deletados: [99]")
+			_, _, err = executarComando(t, cliente, SaidaTabela, "buscar", "--id", "1")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestComandos_Uso(t *testing.T) {
+	cliente := &clienteServico{service: app.NewRecebedorService(memoria.NewRecebedorRepository(), zap.NewNop())}
+	_, stderr, err := executarComando(t, cliente, SaidaTabela, "desconhecido")
+	assert.ErrorIs(t, err, errUso)
+	assert.Contains(t, stderr, "comando desconhecido")
+
+	_, _, err = executarComando(t, cliente, SaidaTabela, "buscar", "--nome", "ana", "--status", domain.StatusRascunho)
+	assert.Error(t, err)
+	_, _, err = executarComando(t, cliente, SaidaTabela, "validar", "abc")
+	assert.EqualError(t, err, `id inválido: "abc"`)
+}
+
+func TestClienteHttp_RepeteRequisicaoLimitada(t *testing.T) {
+	tentativas := 0
+	servidor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tentativas++
+		assert.Equal(t, identificadorCliente, r.Header.Get("X-Client-Id"))
+		if tentativas == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer servidor.Close()
+
+	err := NewClienteHttp(servidor.URL, servidor.Client()).Validar(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, tentativas)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+const (
+	SaidaTabela = "tabela"
+	SaidaJson   = "json"
+)
+
+// escreve os resultados dos comandos na tabela ou em JSON
+type saida struct {
+	formato string
+	w       io.Writer
+}
+
+func (s *saida) json(valor any) error {
+	encoder := json.NewEncoder(s.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(valor)
+}
+
+func (s *saida) tabela(recebedores []*domain.Recebedor) error {
+	tabela := tabwriter.NewWriter(s.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tabela, "ID\tNOME\tCPF/CNPJ\tTIPO CHAVE\tCHAVE PIX\tSTATUS\tEMAIL")
+	for _, r := range recebedores {
+		chave := r.ChavePix
+		if r.ChavePixFormatada != "" {
+			chave = r.ChavePixFormatada
+		}
+		fmt.Fprintf(tabela, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Id, r.Nome, r.CpfCnpj, r.TipoChavePix, chave, r.Status, r.Email)
+	}
+	return tabela.Flush()
+}
+
+func (s *saida) Recebedor(recebedor *domain.Recebedor) error {
+	if s.formato == SaidaJson {
+		return s.json(recebedor)
+	}
+	return s.tabela([]*domain.Recebedor{recebedor})
+}
+
+func (s *saida) Pagina(pagina *domain.PaginaRecebedores) error {
+	if s.formato == SaidaJson {
+		return s.json(pagina)
+	}
+	if err := s.tabela(pagina.Recebedores); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(s.w, "\npágina %d de %d, %d recebedores\n", pagina.PaginaAtual, pagina.TotalPaginas, pagina.Total)
+	return err
+}
+
+func (s *saida) Recebedores(recebedores []*domain.Recebedor) error {
+	if s.formato == SaidaJson {
+		return s.json(recebedores)
+	}
+	return s.tabela(recebedores)
+}
+
+// mensagem de conclusão de um comando, em JSON é escrita como {"mensagem": ...}
+func (s *saida) Mensagem(formato string, args ...any) error {
+	mensagem := fmt.Sprintf(formato, args...)
+	if s.formato == SaidaJson {
+		return s.json(map[string]string{"mensagem": mensagem})
+	}
+	_, err := fmt.Fprintln(s.w, mensagem)
+	return err
+}
@@ -1,11 +1,15 @@
 package app
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
 	"github.com/flaviorodolfo/transfeera-challenge/internal/app/validator"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/brcode"
 	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 )
 
@@ -16,93 +20,193 @@ const (
 	campoTipoChavePix = "tipo_chave_pix"
 	campoStatus       = "status_recebedor"
 
-	porPagina = 10 //valor padrão da paginação
+	porPaginaPadrao = 10 //valor padrão da paginação
 
+	//o recebedor não possui cidade cadastrada, o BR Code exige o campo preenchido
+	cidadeBrCodePadrao = "SAO PAULO"
 )
 
 type RecebedorService struct {
-	repo   domain.RecebedorRepository
-	logger *zap.Logger
+	repo      domain.RecebedorRepository
+	logger    *zap.Logger
+	porPagina int
+	cidade    string
+	triagem   *TriagemService
+	emails    *VerificacaoEmailService
 }
 
-func NewRecebedorService(repo domain.RecebedorRepository, logger *zap.Logger) *RecebedorService {
-	return &RecebedorService{repo: repo, logger: logger}
+// configuração opcional do RecebedorService
+type Opcao func(*RecebedorService)
+
+var tracer = otel.Tracer("github.com/flaviorodolfo/transfeera-challenge/internal/app")
+
+// contabiliza os erros das tarefas em segundo plano, que não são retornados a nenhum cliente
+// e por isso não passam pelas métricas das apis
+type RegistroErros func(tarefa string, err error)
+
+func semRegistroErros(tarefa string, err error) {}
+
+// quantidade de recebedores retornados em cada página das buscas
+func ComTamanhoPagina(porPagina int) Opcao {
+	return func(s *RecebedorService) {
+		s.porPagina = porPagina
+	}
+}
+
+// cidade informada nos BR Codes gerados, o recebedor não possui cidade cadastrada
+func ComCidadeBrCode(cidade string) Opcao {
+	return func(s *RecebedorService) {
+		s.cidade = cidade
+	}
+}
+
+// verifica os recebedores criados e editados nas listas restritivas, os recebedores com
+// correspondência são bloqueados em vez de rejeitados
+func ComTriagem(triagem *TriagemService) Opcao {
+	return func(s *RecebedorService) {
+		s.triagem = triagem
+	}
+}
+
+// envia a verificação do email aos recebedores criados e aos recebedores com o email alterado
+func ComVerificacaoEmail(emails *VerificacaoEmailService) Opcao {
+	return func(s *RecebedorService) {
+		s.emails = emails
+	}
+}
+
+func NewRecebedorService(repo domain.RecebedorRepository, logger *zap.Logger, opcoes ...Opcao) *RecebedorService {
+	s := &RecebedorService{repo: repo, logger: logger, porPagina: porPaginaPadrao, cidade: cidadeBrCodePadrao}
+	for _, opcao := range opcoes {
+		opcao(s)
+	}
+	return s
+}
+
+// logger com os identificadores do trace da operação
+func (s *RecebedorService) log(ctx context.Context) *zap.Logger {
+	return rastreamento.Logger(ctx, s.logger)
 }
 
 // cria um recebedor, retornar erro se algum dos campos é inválido
-func (s *RecebedorService) CriarRecebedor(recebedor *domain.Recebedor) error {
+func (s *RecebedorService) CriarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	ctx, span := tracer.Start(ctx, "RecebedorService.CriarRecebedor")
+	defer span.End()
 	if err := validarUsuario(recebedor); err != nil {
-		s.logger.Error("validando recebedor", zap.Error(err))
+		s.log(ctx).Error("validando recebedor", zap.Error(err))
 		return err
 	}
 	//normalização do nome do usuário e email e cpf/cnpj
 	normalizarCampos(recebedor)
-	chave, err := s.repo.BuscarChave(recebedor.ChavePix)
+	chave, err := s.repo.BuscarChave(ctx, recebedor.ChavePix)
 	if err != nil {
-		s.logger.Error("buscando chave recebedor", zap.Error(err), zap.String("chave", recebedor.ChavePix))
+		s.log(ctx).Error("buscando chave recebedor", zap.Error(err), zap.String("chave", recebedor.ChavePix))
 		return err
 	}
 	if chave == recebedor.ChavePix {
 		return domain.ErrChavePixJaCadastrada
 	}
 	//por definição o status do recebedor no cadastro é Rascunho.
-	recebedor.Status = "Rascunho"
-	if err := s.repo.CriarRecebedor(recebedor); err != nil {
-		s.logger.Error("salvando recebedor", zap.Error(err))
+	recebedor.Status = domain.StatusRascunho
+	var correspondencias []*domain.OcorrenciaTriagem
+	if s.triagem != nil {
+		if correspondencias = s.triagem.Verificar(recebedor); len(correspondencias) > 0 {
+			recebedor.Status = domain.StatusBloqueado
+		}
+	}
+	if err := s.repo.CriarRecebedor(ctx, recebedor); err != nil {
+		s.log(ctx).Error("salvando recebedor", zap.Error(err))
 		return err
 	}
-	s.logger.Info("Recebedor criado com sucesso", zap.Uint("ID", recebedor.Id))
+	s.log(ctx).Info("Recebedor criado com sucesso", zap.Uint("ID", recebedor.Id))
+	if len(correspondencias) > 0 {
+		//o recebedor já foi criado bloqueado, as ocorrências não registradas são registradas na próxima triagem
+		if _, err := s.triagem.registrar(ctx, recebedor.Id, correspondencias); err != nil {
+			s.log(ctx).Error("registrando ocorrências da triagem", zap.Error(err), zap.Uint("recebedor_id", recebedor.Id))
+		}
+	}
+	if s.emails != nil {
+		s.emails.Solicitar(ctx, recebedor)
+	}
 	return nil
 }
 
 // cria um recebedor, retornar erro se algum dos campos é inválido ou se
 // o recebedor tem status Validado
-func (s *RecebedorService) EditarRecebedor(recebedor *domain.Recebedor) error {
-	oldRecebedor, err := s.BuscarRecebedorById(recebedor.Id)
+func (s *RecebedorService) EditarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	ctx, span := tracer.Start(ctx, "RecebedorService.EditarRecebedor")
+	defer span.End()
+	oldRecebedor, err := s.BuscarRecebedorById(ctx, recebedor.Id)
 	if err != nil {
-		s.logger.Error("consultando recebedor", zap.Error(err))
+		s.log(ctx).Error("consultando recebedor", zap.Error(err))
 		return err
 	}
-	if oldRecebedor.Status == "Validado" {
+	if oldRecebedor.Status == domain.StatusValidado {
 		return domain.ErrRecebedorNaoPermiteEdicao
 
 	}
 	if err := validarUsuario(recebedor); err != nil {
-		s.logger.Error("validando recebedor", zap.Error(err))
+		s.log(ctx).Error("validando recebedor", zap.Error(err))
 		return err
 	}
 	//normalização do nome do usuário e email e cpf/cnpj
 	normalizarCampos(recebedor)
-	chave, err := s.repo.BuscarChave(recebedor.ChavePix)
+	chave, err := s.repo.BuscarChave(ctx, recebedor.ChavePix)
 	if err != nil {
-		s.logger.Error("buscando chave recebedor", zap.Error(err), zap.String("chave", recebedor.ChavePix))
+		s.log(ctx).Error("buscando chave recebedor", zap.Error(err), zap.String("chave", recebedor.ChavePix))
 		return err
 	}
 	if chave == recebedor.ChavePix {
 		return domain.ErrChavePixJaCadastrada
 	}
-	if err := s.repo.EditarRecebedor(recebedor); err != nil {
-		s.logger.Error("editando recebedor", zap.Error(err))
+	if err := s.repo.EditarRecebedor(ctx, recebedor); err != nil {
+		s.log(ctx).Error("editando recebedor", zap.Error(err))
 		return err
 	}
-	s.logger.Info("recebedor editado com sucesso", zap.Uint("recebedor_id", recebedor.Id))
+	s.log(ctx).Info("recebedor editado com sucesso", zap.Uint("recebedor_id", recebedor.Id))
+	recebedor.Status = oldRecebedor.Status
+	//a edição já foi salva, em caso de erro o recebedor é verificado novamente na próxima triagem
+	if s.triagem != nil {
+		if err := s.triagem.triar(ctx, recebedor); err != nil {
+			s.log(ctx).Error("triando recebedor editado", zap.Error(err), zap.Uint("recebedor_id", recebedor.Id))
+		}
+	}
+	if s.emails != nil && recebedor.Email != oldRecebedor.Email {
+		s.emails.Solicitar(ctx, recebedor)
+	}
 	return nil
 }
 
 // retorna uma lista de recebedores de acordo com os parametros informados
 // retorna erro em caso de problema na conexão com o repositório
-func (s *RecebedorService) buscarRecebedoresPorCampo(nome, nomeDoCampo string, pagina int) (*domain.PaginaRecebedores, error) {
-	totalRegistros, err := s.repo.ContarRecebedoresPorCampo(nome, nomeDoCampo)
+func (s *RecebedorService) buscarRecebedoresPorCampo(ctx context.Context, nome, nomeDoCampo string, pagina int) (*domain.PaginaRecebedores, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.buscarRecebedoresPorCampo")
+	defer span.End()
+	totalRegistros, err := s.repo.ContarRecebedoresPorCampo(ctx, nome, nomeDoCampo)
 	if err != nil {
-		s.logger.Error("consulta quantidade de registro de recebedores", zap.Error(err))
+		s.log(ctx).Error("consulta quantidade de registro de recebedores", zap.Error(err))
 		return nil, err
 	}
-	recebedores, err := s.repo.BuscarRecebedoresPorCampo(nome, nomeDoCampo, (pagina-1)*10)
+	porPagina := s.tamanhoPagina()
+	recebedores, err := s.repo.BuscarRecebedoresPorCampo(ctx, nome, nomeDoCampo, porPagina, (pagina-1)*porPagina)
 	if err != nil {
-		s.logger.Error("consulta de recebedores", zap.Error(err))
+		s.log(ctx).Error("consulta de recebedores", zap.Error(err))
 		return nil, err
 	}
-	//calculo dos metadados da paginação
+	formatarChavesTelefone(recebedores...)
+	return paginar(recebedores, totalRegistros, pagina, porPagina), nil
+}
+
+// retorna a quantidade de recebedores por página, o valor padrão é usado se não configurado
+func (s *RecebedorService) tamanhoPagina() int {
+	if s.porPagina <= 0 {
+		return porPaginaPadrao
+	}
+	return s.porPagina
+}
+
+// monta a pagina de recebedores com o calculo dos metadados da paginação
+func paginar(recebedores []*domain.Recebedor, totalRegistros, pagina, porPagina int) *domain.PaginaRecebedores {
 	totalPaginas := totalRegistros / porPagina
 	if resto := totalRegistros % porPagina; resto != 0 {
 		totalPaginas++
@@ -113,15 +217,17 @@ func (s *RecebedorService) buscarRecebedoresPorCampo(nome, nomeDoCampo string, p
 		PaginaAtual:  pagina,
 		TotalPaginas: totalPaginas,
 		Recebedores:  recebedores,
-	}, nil
+	}
 }
 
 // retorna uma lista de recebedores com o nome informado e os metadados da paginacao
 // ou erro em caso de problema na conexão com o repositório
-func (s *RecebedorService) BuscarRecebedoresPorNome(nome string, pagina int) (*domain.PaginaRecebedores, error) {
-	recebedores, err := s.buscarRecebedoresPorCampo(strings.ToLower(nome), campoNome, pagina)
+func (s *RecebedorService) BuscarRecebedoresPorNome(ctx context.Context, nome string, pagina int) (*domain.PaginaRecebedores, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.BuscarRecebedoresPorNome")
+	defer span.End()
+	recebedores, err := s.buscarRecebedoresPorCampo(ctx, strings.ToLower(nome), campoNome, pagina)
 	if err != nil {
-		s.logger.Error("consulta de recebedores por nome", zap.Error(err))
+		s.log(ctx).Error("consulta de recebedores por nome", zap.Error(err))
 		return nil, err
 	}
 	return recebedores, nil
@@ -129,107 +235,210 @@ func (s *RecebedorService) BuscarRecebedoresPorNome(nome string, pagina int) (*d
 
 // retorna uma lista de recebedores com o status informado e os metadados da paginacao
 // ou erro em caso de problema na conexão com o repositório
-func (s *RecebedorService) BuscarRecebedoresPorStatus(status string, pagina int) (*domain.PaginaRecebedores, error) {
+func (s *RecebedorService) BuscarRecebedoresPorStatus(ctx context.Context, status string, pagina int) (*domain.PaginaRecebedores, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.BuscarRecebedoresPorStatus")
+	defer span.End()
 
-	recebedores, err := s.buscarRecebedoresPorCampo(status, campoStatus, pagina)
+	recebedores, err := s.buscarRecebedoresPorCampo(ctx, status, campoStatus, pagina)
 	if err != nil {
-		s.logger.Error("consulta de recebedores por status", zap.Error(err))
+		s.log(ctx).Error("consulta de recebedores por status", zap.Error(err))
 		return nil, err
 	}
 	return recebedores, nil
 }
 
-// retorna uma lista de recebedores com a chave informada e os metadados da paginacao
-// ou erro em caso de problema na conexão com o repositório ou formato de chave inválida
-func (s *RecebedorService) BuscarRecebedoresPorChave(chave string, pagina int) (*domain.PaginaRecebedores, error) {
-	if !isChavePixValida(chave) {
-		return nil, domain.ErrChaveInvalida
+// retorna uma lista de recebedores com a chave informada e os metadados da paginacao.
+// Se o tipo for informado a chave é buscada apenas nesse tipo, senão são buscadas todas as formas
+// normalizadas da chave nos tipos em que ela é válida (ex: 11 dígitos podem ser CPF e telefone) e cada
+// recebedor é anotado com o tipo correspondente.
+// Retorna erro em caso de problema na conexão com o repositório, formato de chave inválida ou
+// chave que não corresponde ao tipo informado
+func (s *RecebedorService) BuscarRecebedoresPorChave(ctx context.Context, chave, tipoChave string, pagina int) (*domain.PaginaRecebedores, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.BuscarRecebedoresPorChave")
+	defer span.End()
+	tipos, err := tiposDaChave(chave, domain.TipoChavePix(tipoChave))
+	if err != nil {
+		return nil, err
+	}
+	chaves := map[string]domain.TipoChavePix{}
+	valores := []string{}
+	for _, tipo := range tipos {
+		valor := normalizarChave(chave, tipo)
+		if _, ok := chaves[valor]; !ok {
+			valores = append(valores, valor)
+		}
+		chaves[valor] = tipo
+	}
+	var recebedores *domain.PaginaRecebedores
+	if len(valores) == 1 {
+		recebedores, err = s.buscarRecebedoresPorCampo(ctx, valores[0], campoChavePix, pagina)
+	} else {
+		recebedores, err = s.buscarRecebedoresPorChaves(ctx, valores, pagina)
 	}
-	chave = normalizarChave(chave, getTipoChave(chave))
-	recebedores, err := s.buscarRecebedoresPorCampo(chave, campoChavePix, pagina)
 	if err != nil {
-		s.logger.Error("consulta de recebedores por chave", zap.Error(err))
+		s.log(ctx).Error("consulta de recebedores por chave", zap.Error(err))
 		return nil, err
 	}
+	for _, recebedor := range recebedores.Recebedores {
+		recebedor.TipoCorrespondente = chaves[recebedor.ChavePix]
+	}
+	recebedores.TiposChave = tipos
 	return recebedores, nil
 }
 
+// retorna os tipos em que a chave deve ser buscada: o tipo informado, se ele corresponder
+// com a chave, ou todos os tipos em que a chave é válida
+func tiposDaChave(chave string, tipo domain.TipoChavePix) ([]domain.TipoChavePix, error) {
+	if tipo != "" {
+		if !isTipoValido(tipo) {
+			return nil, domain.ErrTipoChaveInvalida
+		}
+		if !isTipoChavePixValida(chave, tipo) {
+			return nil, domain.ErrChaveTipoNaoCorresponde
+		}
+		return []domain.TipoChavePix{tipo}, nil
+	}
+	tipos := getTiposChave(chave)
+	if len(tipos) == 0 {
+		return nil, domain.ErrChaveInvalida
+	}
+	return tipos, nil
+}
+
+// retorna uma pagina de recebedores cuja chave é qualquer uma das chaves informadas
+func (s *RecebedorService) buscarRecebedoresPorChaves(ctx context.Context, chaves []string, pagina int) (*domain.PaginaRecebedores, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.buscarRecebedoresPorChaves")
+	defer span.End()
+	totalRegistros, err := s.repo.ContarRecebedoresPorChaves(ctx, chaves)
+	if err != nil {
+		s.log(ctx).Error("consulta quantidade de registro de recebedores", zap.Error(err))
+		return nil, err
+	}
+	porPagina := s.tamanhoPagina()
+	recebedores, err := s.repo.BuscarRecebedoresPorChaves(ctx, chaves, porPagina, (pagina-1)*porPagina)
+	if err != nil {
+		s.log(ctx).Error("consulta de recebedores", zap.Error(err))
+		return nil, err
+	}
+	formatarChavesTelefone(recebedores...)
+	return paginar(recebedores, totalRegistros, pagina, porPagina), nil
+}
+
 // retorna uma lista de recebedores com o tipo de chave informado e os metadados da paginacao
 // ou erro em caso de problema na conexão com o repositório ou tipo de chave inválida
-func (s *RecebedorService) BuscarRecebedoresPorTipoChavePix(tipoChave string, pagina int) (*domain.PaginaRecebedores, error) {
+func (s *RecebedorService) BuscarRecebedoresPorTipoChavePix(ctx context.Context, tipoChave string, pagina int) (*domain.PaginaRecebedores, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.BuscarRecebedoresPorTipoChavePix")
+	defer span.End()
 	tipo := domain.TipoChavePix(tipoChave)
 	if !isTipoValido(tipo) {
 		return nil, domain.ErrTipoChaveInvalida
 	}
-	recebedores, err := s.buscarRecebedoresPorCampo(tipoChave, campoTipoChavePix, pagina)
+	recebedores, err := s.buscarRecebedoresPorCampo(ctx, tipoChave, campoTipoChavePix, pagina)
 	if err != nil {
-		s.logger.Error("consulta de recebedores por tipo chave pix", zap.Error(err))
+		s.log(ctx).Error("consulta de recebedores por tipo chave pix", zap.Error(err))
 		return nil, err
 	}
 	return recebedores, nil
 }
-func (s *RecebedorService) EditarEmailRecebedor(id uint, email string) error {
+func (s *RecebedorService) EditarEmailRecebedor(ctx context.Context, id uint, email string) error {
+	ctx, span := tracer.Start(ctx, "RecebedorService.EditarEmailRecebedor")
+	defer span.End()
 
 	if !validator.ValidarEmail(email) {
-		s.logger.Info("email inválido", zap.String("email", email))
+		s.log(ctx).Info("email inválido", zap.String("email", email))
 		return domain.ErrEmailInvalido
 	}
-	_, err := s.repo.BuscarRecebedorPorId(id)
+	recebedor, err := s.repo.BuscarRecebedorPorId(ctx, id)
 	if err != nil {
-		s.logger.Error("consultando recebedor", zap.Error(err))
+		s.log(ctx).Error("consultando recebedor", zap.Error(err))
 		return err
 	}
 	//normalizacao email
 	email = strings.ToLower(email)
-	err = s.repo.EditarEmailRecebedor(id, email)
+	err = s.repo.EditarEmailRecebedor(ctx, id, email)
 	if err != nil {
-		s.logger.Error("atualizando email recebedor", zap.Error(err))
+		s.log(ctx).Error("atualizando email recebedor", zap.Error(err))
 		return err
 
 	}
+	if s.emails != nil && recebedor != nil && recebedor.Email != email {
+		recebedor.Email = email
+		s.emails.Solicitar(ctx, recebedor)
+	}
 	return nil
 }
 
 // retorna um recebedor de acordo com o id informado
 // ou erro em caso de problema na conexão com o repositório ou recebedor inexistente
-func (s *RecebedorService) BuscarRecebedorById(id uint) (*domain.Recebedor, error) {
-	recebedor, err := s.repo.BuscarRecebedorPorId(id)
+func (s *RecebedorService) BuscarRecebedorById(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.BuscarRecebedorById")
+	defer span.End()
+	recebedor, err := s.repo.BuscarRecebedorPorId(ctx, id)
 	if err != nil {
-		s.logger.Error("consultando recebedor", zap.Error(err))
+		s.log(ctx).Error("consultando recebedor", zap.Error(err))
 		return nil, err
 	}
 	if recebedor == nil {
 		return nil, domain.ErrRecebedorNaoEncontrado
 	}
+	formatarChavesTelefone(recebedor)
 	return recebedor, nil
 
 }
 
 // deleta um recebedor de acordo com o id, retorna erro em caso de recebedor nao existente
 // ou problema na conexao com o repositorio
-func (s *RecebedorService) DeletarRecebedor(id uint) error {
-	if _, err := s.BuscarRecebedorById(id); err != nil {
+func (s *RecebedorService) DeletarRecebedor(ctx context.Context, id uint) error {
+	ctx, span := tracer.Start(ctx, "RecebedorService.DeletarRecebedor")
+	defer span.End()
+	if _, err := s.BuscarRecebedorById(ctx, id); err != nil {
 		return err
 	}
-	err := s.repo.DeletarRecebedor(id)
+	err := s.repo.DeletarRecebedor(ctx, id)
 	if err != nil {
-		s.logger.Error("deletando recebedor", zap.Error(err))
+		s.log(ctx).Error("deletando recebedor", zap.Error(err))
 		return err
 	}
 	return nil
 
 }
 
+// altera o status do recebedor para Validado, após a validação apenas o email pode ser editado.
+// Retorna erro em caso de recebedor inexistente, bloqueado ou problema na conexão com o repositório
+func (s *RecebedorService) ValidarRecebedor(ctx context.Context, id uint) error {
+	ctx, span := tracer.Start(ctx, "RecebedorService.ValidarRecebedor")
+	defer span.End()
+	recebedor, err := s.BuscarRecebedorById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if recebedor.Status == domain.StatusValidado {
+		return nil
+	}
+	if recebedor.Status == domain.StatusBloqueado {
+		return domain.ErrRecebedorBloqueado
+	}
+	if err := s.repo.EditarStatusRecebedor(ctx, id, domain.StatusValidado); err != nil {
+		s.log(ctx).Error("validando recebedor", zap.Error(err))
+		return err
+	}
+	recebedor.Status = domain.StatusValidado
+	s.log(ctx).Info("recebedor validado com sucesso", zap.Uint("recebedor_id", id))
+	return nil
+}
+
 // deleta um N recebedores de acordo com os ids informados, caso um ou mais ids não
 // existam retorna um erro informando quais foram deletados e quais não
 // também retorna erro caso ocorra problema na conexao com o repositorio
-func (s *RecebedorService) DeletarRecebedores(ids []uint) error {
+func (s *RecebedorService) DeletarRecebedores(ctx context.Context, ids []uint) error {
+	ctx, span := tracer.Start(ctx, "RecebedorService.DeletarRecebedores")
+	defer span.End()
 	idsSemSucesso := []uint{}
 	idsComSucesso := []uint{}
 	var hasError bool
 	// para cada tentativa de delete ocorre o registro do que obteve sucesso e do que não
 	for _, id := range ids {
-		if err := s.DeletarRecebedor(id); err != nil {
+		if err := s.DeletarRecebedor(ctx, id); err != nil {
 			hasError = true
 			idsSemSucesso = append(idsSemSucesso, id)
 		} else {
@@ -240,17 +449,119 @@ func (s *RecebedorService) DeletarRecebedores(ids []uint) error {
 		return domain.ErrRecebedoresNaoDeletados{IdsComSucesso: idsComSucesso, IdsSemSucesso: idsSemSucesso}
 	}
 	//for
-	// err := s.repo.DeletarRecebedores(ids)
+	// err := s.repo.DeletarRecebedores(ctx, ids)
 	// if err != nil {
-	// 	s.logger.Error("deletando recebedores", zap.Error(err))
+	// 	s.log(ctx).Error("deletando recebedores", zap.Error(err))
 	// 	return nil
 	// }
 	return nil
 
 }
 
+// gera o BR Code (pix copia e cola) estático do recebedor de acordo com o id informado,
+// retorna erro em caso de recebedor inexistente, bloqueado ou valor, txid ou descrição inválidos
+func (s *RecebedorService) GerarBrCode(ctx context.Context, id uint, valor float64, txId, descricao string) (string, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.GerarBrCode")
+	defer span.End()
+	recebedor, err := s.BuscarRecebedorById(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	//assim como na validação, recebedores bloqueados pela triagem não recebem cobranças
+	if recebedor.Status == domain.StatusBloqueado {
+		return "", domain.ErrRecebedorBloqueado
+	}
+	payload := brcode.Payload{
+		Chave:         chaveDict(recebedor.ChavePix, recebedor.TipoChavePix),
+		Descricao:     descricao,
+		NomeRecebedor: recebedor.Nome,
+		Cidade:        s.cidadeBrCode(),
+		TxId:          txId,
+		Valor:         valor,
+	}
+	codigo, err := payload.Gerar()
+	if err != nil {
+		s.log(ctx).Info("gerando br code", zap.Error(err), zap.Uint("recebedor_id", id))
+		return "", err
+	}
+	return codigo, nil
+}
+
+func (s *RecebedorService) cidadeBrCode() string {
+	if s.cidade == "" {
+		return cidadeBrCodePadrao
+	}
+	return s.cidade
+}
+
+// decodifica o BR Code informado e cria um recebedor em Rascunho com a chave, o tipo de chave
+// detectado e o nome do recebedor. O cpf/cnpj, quando não informado, é obtido da chave se ela for
+// do tipo CPF ou CNPJ. Se preview é true o recebedor apenas é montado e retornado, sem ser persistido
+func (s *RecebedorService) CriarRecebedorPorBrCode(ctx context.Context, codigo, cpfCnpj, email string, preview bool) (*domain.Recebedor, error) {
+	ctx, span := tracer.Start(ctx, "RecebedorService.CriarRecebedorPorBrCode")
+	defer span.End()
+	payload, err := brcode.Decodificar(codigo)
+	if err != nil {
+		s.log(ctx).Info("decodificando br code", zap.Error(err))
+		return nil, err
+	}
+	if !isChavePixValida(payload.Chave) {
+		return nil, domain.ErrChaveInvalida
+	}
+	tipo := getTipoChave(payload.Chave)
+	if cpfCnpj == "" && (tipo == domain.Cpf || tipo == domain.Cnpj) {
+		cpfCnpj = payload.Chave
+	}
+	recebedor := &domain.Recebedor{
+		CpfCnpj:      cpfCnpj,
+		Nome:         payload.NomeRecebedor,
+		TipoChavePix: tipo,
+		ChavePix:     payload.Chave,
+		Email:        email,
+	}
+	if preview {
+		//o cpf/cnpj pode ser informado depois da prévia, os demais campos são validados como na criação
+		if err := validarCampos(recebedor, false); err != nil {
+			s.log(ctx).Info("validando prévia do recebedor", zap.Error(err))
+			return nil, err
+		}
+		if cpfCnpj != "" {
+			normalizarCampos(recebedor)
+		} else {
+			recebedor.Nome = strings.ToLower(recebedor.Nome)
+			recebedor.Email = strings.ToLower(recebedor.Email)
+			recebedor.ChavePix = normalizarChave(recebedor.ChavePix, tipo)
+		}
+		recebedor.Status = domain.StatusRascunho
+		return recebedor, nil
+	}
+	if err := s.CriarRecebedor(ctx, recebedor); err != nil {
+		return nil, err
+	}
+	return recebedor, nil
+}
+
+// retorna a chave no formato utilizado pelo DICT: cpf e cnpj sem máscara
+// e telefone com o código do país
+func chaveDict(chave string, tipo domain.TipoChavePix) string {
+	switch tipo {
+	case domain.Cpf, domain.Cnpj:
+		return removerMascaraCpfCnpj(chave)
+	case domain.Telefone:
+		return normalizarTelefone(chave)
+	case domain.Email:
+		return strings.ToLower(chave)
+	}
+	return chave
+}
+
 // valida os campos de um usuário
 func validarUsuario(recebedor *domain.Recebedor) error {
+	return validarCampos(recebedor, true)
+}
+
+// valida os campos de um usuário, sem exigirDocumento o cpf/cnpj é validado apenas se informado
+func validarCampos(recebedor *domain.Recebedor, exigirDocumento bool) error {
 	if !isNomeValido(recebedor.Nome) {
 		return domain.ErrNomeInvalido
 	}
@@ -259,8 +570,10 @@ func validarUsuario(recebedor *domain.Recebedor) error {
 			return domain.ErrEmailInvalido
 		}
 	}
-	if err := validarCpfCnpj(recebedor.CpfCnpj); err != nil {
-		return err
+	if exigirDocumento || recebedor.CpfCnpj != "" {
+		if err := validarCpfCnpj(recebedor.CpfCnpj); err != nil {
+			return err
+		}
 	}
 	if !isTipoValido(recebedor.TipoChavePix) {
 		return domain.ErrTipoChaveInvalida
@@ -281,23 +594,29 @@ func formatarCpf(cpf string) string {
 	return re.ReplaceAllString(cpf, "$1.$2.$3-$4")
 }
 
-// Função para formatar CNPJ para o padrão XX.XXX.XXX/XXXX-XX
+// Função para formatar CNPJ, numérico ou alfanumérico, para o padrão XX.XXX.XXX/XXXX-XX
 func formatarCnpj(cnpj string) string {
-	re := regexp.MustCompile(`(\d{2})(\d{3})(\d{3})(\d{4})(\d{2})`)
+	re := regexp.MustCompile(`([0-9A-Z]{2})([0-9A-Z]{3})([0-9A-Z]{3})([0-9A-Z]{4})(\d{2})`)
 	return re.ReplaceAllString(cnpj, "$1.$2.$3/$4-$5")
 }
 
+// remove a máscara do cpf ou cnpj mantendo apenas dígitos e letras em maiúsculo,
+// as letras são mantidas para suportar o CNPJ alfanumérico
+func removerMascaraCpfCnpj(cpfCnpj string) string {
+	re := regexp.MustCompile(`[^0-9A-Z]`)
+	return re.ReplaceAllString(strings.ToUpper(cpfCnpj), "")
+}
+
 // normaliza os campos de nome, email, cpfCnpj ou chave pix caso necessario
 func normalizarCampos(recebedor *domain.Recebedor) {
 
-	re := regexp.MustCompile(`[^\d]`)
-	//remove todos os nao digitos do cpfCnpj
-	recebedor.CpfCnpj = re.ReplaceAllString(recebedor.CpfCnpj, "")
-	//se for CPF aplica a máscara CPF
+	//remove a máscara do cpfCnpj
+	recebedor.CpfCnpj = removerMascaraCpfCnpj(recebedor.CpfCnpj)
+	//se for CNPJ aplica a máscara CNPJ
 	if len(recebedor.CpfCnpj) > 11 {
 		recebedor.CpfCnpj = formatarCnpj(recebedor.CpfCnpj)
 	} else {
-		//senao a máscara CNPJ
+		//senao a máscara CPF
 		recebedor.CpfCnpj = formatarCpf(recebedor.CpfCnpj)
 	}
 	recebedor.Email = strings.ToLower(recebedor.Email)
@@ -310,7 +629,7 @@ func normalizarChave(chave string, tipo domain.TipoChavePix) string {
 	if tipo == domain.Cpf {
 		return formatarCpf(re.ReplaceAllString(chave, ""))
 	} else if tipo == domain.Cnpj {
-		return formatarCnpj(re.ReplaceAllString(chave, ""))
+		return formatarCnpj(removerMascaraCpfCnpj(chave))
 	} else if tipo == domain.Telefone {
 		return normalizarTelefone(chave)
 	} else if tipo == domain.Email {
@@ -319,19 +638,33 @@ func normalizarChave(chave string, tipo domain.TipoChavePix) string {
 	return chave
 }
 
+// converte o telefone para o formato E.164 (+55DDNNNNNNNNN), o valor é mantido
+// caso não seja um telefone válido
 func normalizarTelefone(telefone string) string {
-	//se está com +55...
-	if len(telefone) == 13 {
-		return telefone[3:]
-	} else if len(telefone) == 12 {
-		return telefone[2:]
+	if e164, ok := validator.NormalizarTelefone(telefone); ok {
+		return e164
 	}
 	return telefone
 }
 
-// remove todos não digitos da string e valida o CPF ou CNPJ
+// formata um telefone no padrão E.164 para exibição, ex: +55 (11) 98765-4321
+func formatarTelefone(telefone string) string {
+	re := regexp.MustCompile(`^\+55(\d{2})(\d{4,5})(\d{4})$`)
+	return re.ReplaceAllString(normalizarTelefone(telefone), "+55 ($1) $2-$3")
+}
+
+// preenche a chave pix formatada para exibição dos recebedores com chave do tipo telefone
+func formatarChavesTelefone(recebedores ...*domain.Recebedor) {
+	for _, recebedor := range recebedores {
+		if recebedor.TipoChavePix == domain.Telefone {
+			recebedor.ChavePixFormatada = formatarTelefone(recebedor.ChavePix)
+		}
+	}
+}
+
+// remove a máscara da string e valida o CPF ou CNPJ
 func validarCpfCnpj(cpfCnpj string) error {
-	cpfCnpj = regexp.MustCompile(`[^\d]`).ReplaceAllString(cpfCnpj, "")
+	cpfCnpj = removerMascaraCpfCnpj(cpfCnpj)
 	if len(cpfCnpj) > 11 {
 
 		if !validator.ValidarCNPJ(cpfCnpj) {
@@ -390,6 +723,17 @@ func getTipoChave(chave string) domain.TipoChavePix {
 	}
 }
 
+// retorna todos os tipos de chave pix em que a chave é válida
+func getTiposChave(chave string) []domain.TipoChavePix {
+	tipos := []domain.TipoChavePix{}
+	for _, tipo := range []domain.TipoChavePix{domain.Cpf, domain.Cnpj, domain.Telefone, domain.Email, domain.ChaveAleatoria} {
+		if isTipoChavePixValida(chave, tipo) {
+			tipos = append(tipos, tipo)
+		}
+	}
+	return tipos
+}
+
 // retorna true se é um tipo de chave pix válido
 func isTipoValido(tipoChave domain.TipoChavePix) bool {
 	switch tipoChave {
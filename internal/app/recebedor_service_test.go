@@ -1,9 +1,12 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/flaviorodolfo/transfeera-challenge/internal/brcode"
 	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -17,22 +20,22 @@ type MockRepository struct {
 	mock.Mock
 }
 
-func (m *MockRepository) CriarRecebedor(recebedor *domain.Recebedor) error {
+func (m *MockRepository) CriarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
 	args := m.Called(recebedor)
 	return args.Error(0)
 }
-func (m *MockRepository) BuscarChave(chave string) (string, error) {
+func (m *MockRepository) BuscarChave(ctx context.Context, chave string) (string, error) {
 	args := m.Called(chave)
 	if args.Get(0) == nil {
 		return "", args.Error(1)
 	}
 	return args.Get(0).(string), args.Error(1)
 }
-func (m *MockRepository) EditarEmailRecebedor(id uint, email string) error {
+func (m *MockRepository) EditarEmailRecebedor(ctx context.Context, id uint, email string) error {
 	args := m.Called(id, email)
 	return args.Error(0)
 }
-func (m *MockRepository) BuscarRecebedorPorId(id uint) (*domain.Recebedor, error) {
+func (m *MockRepository) BuscarRecebedorPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
 	args := m.Called(id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -40,33 +43,56 @@ func (m *MockRepository) BuscarRecebedorPorId(id uint) (*domain.Recebedor, error
 	return args.Get(0).(*domain.Recebedor), args.Error(1)
 }
 
-func (m *MockRepository) EditarRecebedor(recebedor *domain.Recebedor) error {
+func (m *MockRepository) EditarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
 	args := m.Called(recebedor)
 	return args.Error(0)
 }
-func (m *MockRepository) DeletarRecebedor(id uint) error {
+func (m *MockRepository) DeletarRecebedor(ctx context.Context, id uint) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
-func (m *MockRepository) DeletarRecebedores(ids []uint) error {
+func (m *MockRepository) DeletarRecebedores(ctx context.Context, ids []uint) error {
 	args := m.Called(ids)
 	return args.Error(0)
 }
 
-func (m *MockRepository) BuscarRecebedoresPorCampo(valor, nomeCampo string, offset int) ([]*domain.Recebedor, error) {
-	args := m.Called(valor, nomeCampo, offset)
+func (m *MockRepository) BuscarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string, limite, offset int) ([]*domain.Recebedor, error) {
+	args := m.Called(valor, nomeCampo, limite, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domain.Recebedor), args.Error(1)
 }
-func (m *MockRepository) ContarRecebedoresPorCampo(valor, nomeCampo string) (int, error) {
+func (m *MockRepository) ContarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string) (int, error) {
 	args := m.Called(valor, nomeCampo)
 	if args.Get(0) == nil {
 		return 0, args.Error(1)
 	}
 	return args.Get(0).(int), args.Error(1)
 }
+func (m *MockRepository) BuscarRecebedoresPorChaves(ctx context.Context, chaves []string, limite, offset int) ([]*domain.Recebedor, error) {
+	args := m.Called(chaves, limite, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Recebedor), args.Error(1)
+}
+func (m *MockRepository) ContarRecebedoresPorChaves(ctx context.Context, chaves []string) (int, error) {
+	args := m.Called(chaves)
+	if args.Get(0) == nil {
+		return 0, args.Error(1)
+	}
+	return args.Get(0).(int), args.Error(1)
+}
+func (m *MockRepository) EditarStatusRecebedor(ctx context.Context, id uint, status string) error {
+	args := m.Called(id, status)
+	return args.Error(0)
+}
+func (m *MockRepository) VerificarEmailRecebedor(ctx context.Context, id uint, email string, verificadoEm time.Time) (bool, error) {
+	args := m.Called(id, email)
+	return args.Bool(0), args.Error(1)
+}
+
 func mockLogger() *zap.Logger {
 	logger, _ := zap.NewDevelopment()
 	return logger
@@ -90,7 +116,7 @@ func TestDeletarRecebedores_Success(t *testing.T) {
 	repo.On("DeletarRecebedor", uint(2)).Return(nil)
 	repo.On("DeletarRecebedor", uint(3)).Return(nil)
 	repo.On("DeletarRecebedor", uint(4)).Return(nil)
-	err := svc.DeletarRecebedores(ids)
+	err := svc.DeletarRecebedores(context.Background(), ids)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -112,7 +138,7 @@ func TestDeletarRecebedores_SuccessoParcial(t *testing.T) {
 	repo.On("BuscarRecebedorPorId", uint(4)).Return(nil, nil)
 	repo.On("DeletarRecebedor", uint(1)).Return(nil)
 	repo.On("DeletarRecebedor", uint(2)).Return(nil)
-	err := svc.DeletarRecebedores(ids)
+	err := svc.DeletarRecebedores(context.Background(), ids)
 	assert.Error(t, err)
 	assert.Equal(t, mockError, err)
 	repo.AssertExpectations(t)
@@ -154,9 +180,9 @@ func TestBuscarRecebedorPorNome_Success(t *testing.T) {
 	nomeCampo := "nome"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", nome, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", nome, nomeCampo, 0).Return(recebedores, nil)
+	repo.On("BuscarRecebedoresPorCampo", nome, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	response, err := svc.BuscarRecebedoresPorNome(nome, paginacao)
+	response, err := svc.BuscarRecebedoresPorNome(context.Background(), nome, paginacao)
 	assert.NoError(t, err)
 	assert.Equal(t, esperado, response)
 	repo.AssertExpectations(t)
@@ -198,9 +224,9 @@ func TestBuscarRecebedorPorStatus_Success(t *testing.T) {
 	nomeCampo := "status_recebedor"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	response, err := svc.BuscarRecebedoresPorStatus(valorCampo, paginacao)
+	response, err := svc.BuscarRecebedoresPorStatus(context.Background(), valorCampo, paginacao)
 	assert.NoError(t, err)
 	assert.Equal(t, esperado, response)
 	repo.AssertExpectations(t)
@@ -236,15 +262,16 @@ func TestBuscarRecebedorPorChave_Success(t *testing.T) {
 		PaginaAtual:  1,
 		TotalPaginas: 1,
 		Recebedores:  recebedores,
+		TiposChave:   []domain.TipoChavePix{domain.Cnpj},
 	}
 
 	valorCampo := "71.246.868/0001-14"
 	nomeCampo := "chave_pix"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	response, err := svc.BuscarRecebedoresPorChave(valorCampo, paginacao)
+	response, err := svc.BuscarRecebedoresPorChave(context.Background(), valorCampo, "", paginacao)
 	assert.NoError(t, err)
 	assert.Equal(t, esperado, response)
 	repo.AssertExpectations(t)
@@ -258,9 +285,9 @@ func TestBuscarRecebedorPorChave_ErroConsultaRecebedores(t *testing.T) {
 	nomeCampo := "chave_pix"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(nil, errDatabaseError)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(nil, errDatabaseError)
 
-	_, err := svc.BuscarRecebedoresPorChave(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorChave(context.Background(), valorCampo, "", paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -272,9 +299,9 @@ func TestBuscarRecebedorPorChave_ErroConsultaQuantidadeRecebedores(t *testing.T)
 	nomeCampo := "chave_pix"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(0, errDatabaseError)
-	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	_, err := svc.BuscarRecebedoresPorChave(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorChave(context.Background(), valorCampo, "", paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -287,9 +314,9 @@ func TestBuscarRecebedorPorNome_ErroConsultaRecebedores(t *testing.T) {
 	nomeCampo := "nome"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(nil, errDatabaseError)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(nil, errDatabaseError)
 
-	_, err := svc.BuscarRecebedoresPorNome(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorNome(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -301,9 +328,9 @@ func TestBuscarRecebedorPorNome_ErroConsultaQuantidadeRecebedores(t *testing.T)
 	nomeCampo := "nome"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, errDatabaseError)
-	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	_, err := svc.BuscarRecebedoresPorNome(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorNome(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -317,9 +344,9 @@ func TestBuscarRecebedorPorStatus_ErroConsultaRecebedores(t *testing.T) {
 	nomeCampo := "status_recebedor"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(nil, errDatabaseError)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(nil, errDatabaseError)
 
-	_, err := svc.BuscarRecebedoresPorStatus(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorStatus(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -331,9 +358,9 @@ func TestBuscarRecebedorPorStatus_ErroConsultaQuantidadeRecebedores(t *testing.T
 	nomeCampo := "status_recebedor"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, errDatabaseError)
-	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	_, err := svc.BuscarRecebedoresPorStatus(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorStatus(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -347,9 +374,9 @@ func TestBuscarRecebedorPorTipoChave_ErroConsultaRecebedores(t *testing.T) {
 	nomeCampo := "tipo_chave_pix"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(nil, errDatabaseError)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(nil, errDatabaseError)
 
-	_, err := svc.BuscarRecebedoresPorTipoChavePix(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorTipoChavePix(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -361,9 +388,9 @@ func TestBuscarRecebedorPorTipoChave_ErroConsultaQuantidadeRecebedores(t *testin
 	nomeCampo := "tipo_chave_pix"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, errDatabaseError)
-	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	//repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	_, err := svc.BuscarRecebedoresPorTipoChavePix(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorTipoChavePix(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -375,7 +402,7 @@ func TestBuscarRecebedorPorChave_ChaveInvalida(t *testing.T) {
 
 	valorCampo := "xxxxxx"
 	paginacao := 1
-	_, err := svc.BuscarRecebedoresPorChave(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorChave(context.Background(), valorCampo, "", paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChaveInvalida, err)
 	repo.AssertExpectations(t)
@@ -417,9 +444,9 @@ func TestBuscarRecebedorPorTipoChave_Success(t *testing.T) {
 	nomeCampo := "tipo_chave_pix"
 	paginacao := 1
 	repo.On("ContarRecebedoresPorCampo", valorCampo, nomeCampo).Return(2, nil)
-	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 0).Return(recebedores, nil)
+	repo.On("BuscarRecebedoresPorCampo", valorCampo, nomeCampo, 10, 0).Return(recebedores, nil)
 
-	response, err := svc.BuscarRecebedoresPorTipoChavePix(valorCampo, paginacao)
+	response, err := svc.BuscarRecebedoresPorTipoChavePix(context.Background(), valorCampo, paginacao)
 	assert.NoError(t, err)
 	assert.Equal(t, esperado, response)
 	repo.AssertExpectations(t)
@@ -431,7 +458,7 @@ func TestBuscarRecebedorPorTipo_TipoInvalido(t *testing.T) {
 
 	valorCampo := "xxxxxx"
 	paginacao := 1
-	_, err := svc.BuscarRecebedoresPorTipoChavePix(valorCampo, paginacao)
+	_, err := svc.BuscarRecebedoresPorTipoChavePix(context.Background(), valorCampo, paginacao)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrTipoChaveInvalida, err)
 }
@@ -440,7 +467,7 @@ func TestBuscarRecebedor_NaoEncontrado(t *testing.T) {
 	repo := new(MockRepository)
 	svc := &RecebedorService{repo: repo, logger: mockLogger()}
 	repo.On("BuscarRecebedorPorId", uint(2)).Return(nil, nil)
-	recebedor, err := svc.BuscarRecebedorById(uint(2))
+	recebedor, err := svc.BuscarRecebedorById(context.Background(), uint(2))
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrRecebedorNaoEncontrado, err)
 	assert.Nil(t, recebedor)
@@ -459,7 +486,7 @@ func TestDeletarRecebedor_Success(t *testing.T) {
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("DeletarRecebedor", uint(1)).Return(nil)
-	err := svc.DeletarRecebedor(uint(1))
+	err := svc.DeletarRecebedor(context.Background(), uint(1))
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -477,7 +504,7 @@ func TestDeletarRecebedor_ErroAcessoDeleteRecebedor(t *testing.T) {
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("DeletarRecebedor", uint(1)).Return(errDatabaseError)
-	err := svc.DeletarRecebedor(uint(1))
+	err := svc.DeletarRecebedor(context.Background(), uint(1))
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -488,7 +515,7 @@ func TestDeletarRecebedor_ErroAcessoBuscaRecebedor(t *testing.T) {
 	svc := &RecebedorService{repo: repo, logger: mockLogger()}
 
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(nil, errDatabaseError)
-	err := svc.DeletarRecebedor(uint(1))
+	err := svc.DeletarRecebedor(context.Background(), uint(1))
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -507,7 +534,7 @@ func TestEditarRecebedor_Success(t *testing.T) {
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("EditarRecebedor", recebedor).Return(nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -526,7 +553,7 @@ func TestEditarRecebedor_ErroBuscarChave(t *testing.T) {
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", errDatabaseError)
 
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -544,7 +571,7 @@ func TestEditarRecebedor_ErroBuscarRecebedor(t *testing.T) {
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(nil, errDatabaseError)
 
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -564,7 +591,7 @@ func TestEditarRecebedor_ErroAcessoEditarRecebedor(t *testing.T) {
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
 	repo.On("EditarRecebedor", recebedor).Return(errDatabaseError)
 
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -583,7 +610,7 @@ func TestEditarRecebedor_ChaveJaCadastrada(t *testing.T) {
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return(recebedor.ChavePix, nil)
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChavePixJaCadastrada, err)
 	repo.AssertExpectations(t)
@@ -600,7 +627,7 @@ func TestEditarRecebedor_NomeInvalido(t *testing.T) {
 		ChavePix:     "flavio@transfeera.com",
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrNomeInvalido, err)
 	repo.AssertExpectations(t)
@@ -620,7 +647,7 @@ func TestEditarEmailRecebedor_Success(t *testing.T) {
 	email := "flavio@teste.com"
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("EditarEmailRecebedor", uint(1), email).Return(nil)
-	err := svc.EditarEmailRecebedor(uint(1), email)
+	err := svc.EditarEmailRecebedor(context.Background(), uint(1), email)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -629,7 +656,7 @@ func TestEditarEmailRecebedor_EmailInvalido(t *testing.T) {
 	repo := new(MockRepository)
 	svc := &RecebedorService{repo: repo, logger: mockLogger()}
 	email := "flavio@teste"
-	err := svc.EditarEmailRecebedor(uint(1), email)
+	err := svc.EditarEmailRecebedor(context.Background(), uint(1), email)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrEmailInvalido, err)
 	repo.AssertExpectations(t)
@@ -641,7 +668,7 @@ func TestEditarEmailRecebedor_ErroAcessoBuscaRecebedor(t *testing.T) {
 
 	email := "flavio@teste.com"
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(nil, errDatabaseError)
-	err := svc.EditarEmailRecebedor(uint(1), email)
+	err := svc.EditarEmailRecebedor(context.Background(), uint(1), email)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -660,7 +687,7 @@ func TestEditarEmailRecebedor_RecebedorNaoEncontrado(t *testing.T) {
 	email := "flavio@teste.com"
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
 	repo.On("EditarEmailRecebedor", recebedor.Id, email).Return(errDatabaseError)
-	err := svc.EditarEmailRecebedor(uint(1), email)
+	err := svc.EditarEmailRecebedor(context.Background(), uint(1), email)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -678,7 +705,7 @@ func TestEditarRecebedor_CpfInvalido(t *testing.T) {
 		ChavePix:     "flavio@transfeera.com",
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrCpfInvalido, err)
 	repo.AssertExpectations(t)
@@ -695,7 +722,7 @@ func TestEditarRecebedor_RecebedorNaoEncontrado(t *testing.T) {
 		ChavePix:     "flavio@transfeera.com",
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(nil, nil)
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, domain.ErrRecebedorNaoEncontrado)
 	assert.Equal(t, domain.ErrRecebedorNaoEncontrado, err)
 	repo.AssertExpectations(t)
@@ -714,7 +741,7 @@ func TestEditarRecebedor_RecebedorNaoPermiteEdicao(t *testing.T) {
 		Status:       "Validado",
 	}
 	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
-	err := svc.EditarRecebedor(recebedor)
+	err := svc.EditarRecebedor(context.Background(), recebedor)
 	assert.Error(t, domain.ErrRecebedorNaoPermiteEdicao)
 	assert.Equal(t, domain.ErrRecebedorNaoPermiteEdicao, err)
 	repo.AssertExpectations(t)
@@ -734,7 +761,7 @@ func TestCreateRecebedor_SuccessoChaveCpf(t *testing.T) {
 
 	repo.On("CriarRecebedor", recebedor).Return(nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -753,7 +780,7 @@ func TestCreateRecebedor_ErroBuscaChave(t *testing.T) {
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
 	repo.On("CriarRecebedor", recebedor).Return(errDatabaseError)
 
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -772,7 +799,7 @@ func TestCreateRecebedor_ErroCriarRecebedor(t *testing.T) {
 	}
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", errDatabaseError)
 
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, errDatabaseError, err)
 	repo.AssertExpectations(t)
@@ -790,7 +817,7 @@ func TestCreateRecebedor_ChaveJaCadastrada(t *testing.T) {
 	}
 
 	repo.On("BuscarChave", recebedor.ChavePix).Return(recebedor.ChavePix, nil)
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChavePixJaCadastrada, err)
 	repo.AssertExpectations(t)
@@ -810,7 +837,7 @@ func TestCreateRecebedor_SuccessoChaveAleatoria(t *testing.T) {
 
 	repo.On("CriarRecebedor", recebedor).Return(nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 	repo.AssertExpectations(t)
@@ -829,8 +856,9 @@ func TestCreateRecebedor_SuccessoCnpj(t *testing.T) {
 	}
 
 	repo.On("CriarRecebedor", recebedor).Return(nil)
-	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
-	err := svc.CriarRecebedor(recebedor)
+	//chaves do tipo telefone são armazenadas no formato E.164
+	repo.On("BuscarChave", "+5579998765676").Return("", nil)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -848,7 +876,7 @@ func TestCreateRecebedor_Success(t *testing.T) {
 
 	repo.On("CriarRecebedor", recebedor).Return(nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -867,7 +895,7 @@ func TestCreateRecebedor_SuccessChaveCnpj(t *testing.T) {
 
 	repo.On("CriarRecebedor", recebedor).Return(nil)
 	repo.On("BuscarChave", recebedor.ChavePix).Return("", nil)
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.NoError(t, err)
 	repo.AssertExpectations(t)
 }
@@ -885,7 +913,7 @@ func TestCreateRecebedor_EmailInvalido(t *testing.T) {
 		Email:        "joao@example",
 	}
 
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrEmailInvalido, err)
 	repo.AssertExpectations(t)
@@ -904,7 +932,7 @@ func TestCreateRecebedor_ChaveETipoNaoCorresponde(t *testing.T) {
 		ChavePix:     "515.762.030-69",
 		Email:        "joao@example.com",
 	}
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChaveTipoNaoCorresponde, err)
 	repo.AssertExpectations(t)
@@ -923,7 +951,7 @@ func TestCreateRecebedor_TipoChaveInvalida(t *testing.T) {
 		ChavePix:     "515.762.030-69",
 		Email:        "joao@example.com",
 	}
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrTipoChaveInvalida, err)
 	repo.AssertExpectations(t)
@@ -941,7 +969,7 @@ func TestCreateRecebedor_CnpjInvalido(t *testing.T) {
 		ChavePix:     "799965474828",
 		Email:        "joao@example.com",
 	}
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrCnpjInvalido, err)
 	repo.AssertExpectations(t)
@@ -960,7 +988,7 @@ func TestCreateRecebedor_ChavePixInvalida(t *testing.T) {
 		Email:        "joao@example.com",
 	}
 
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChaveInvalida, err)
 	repo.AssertExpectations(t)
@@ -979,7 +1007,7 @@ func TestCreateRecebedor_CpfInvalido(t *testing.T) {
 		ChavePix:     "799965474828",
 		Email:        "joao@example.com",
 	}
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrCpfInvalido, err)
 	repo.AssertExpectations(t)
@@ -998,7 +1026,7 @@ func TestCreateRecebedor_TipoChaveTelefoneInvalida(t *testing.T) {
 		Email:        "joao@example.com",
 	}
 
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChaveInvalida, err)
 	repo.AssertExpectations(t)
@@ -1018,7 +1046,7 @@ func TestCreateRecebedor_TipoChaveAleatoriaInvalida(t *testing.T) {
 		Email:        "joao@example.com",
 	}
 
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChaveInvalida, err)
 	repo.AssertExpectations(t)
@@ -1037,9 +1065,464 @@ func TestCreateRecebedor_TipoChaveCnpjInvalida(t *testing.T) {
 		ChavePix:     "0f1488da7",
 		Email:        "joao@example.com",
 	}
-	err := svc.CriarRecebedor(recebedor)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrChaveInvalida, err)
+	repo.AssertExpectations(t)
+
+}
+
+func TestGerarBrCode_Success(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{
+		Id:           1,
+		CpfCnpj:      "515.762.030-69",
+		Nome:         "joão da silva",
+		TipoChavePix: "CPF",
+		ChavePix:     "515.762.030-69",
+	}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	codigo, err := svc.GerarBrCode(context.Background(), uint(1), 10, "PEDIDO1", "")
+	assert.NoError(t, err)
+	assert.Contains(t, codigo, "0014br.gov.bcb.pix011151576203069")
+	assert.Contains(t, codigo, "5913JOAO DA SILVA")
+	assert.Contains(t, codigo, "540510.00")
+	repo.AssertExpectations(t)
+}
+
+func TestGerarBrCode_Cidade(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewRecebedorService(repo, mockLogger(), ComCidadeBrCode("Aracaju"))
+	recebedor := &domain.Recebedor{Id: 1, CpfCnpj: "515.762.030-69", Nome: "joão da silva", TipoChavePix: "CPF", ChavePix: "515.762.030-69"}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	codigo, err := svc.GerarBrCode(context.Background(), uint(1), 0, "", "")
+	assert.NoError(t, err)
+	assert.Contains(t, codigo, "6007ARACAJU")
+	repo.AssertExpectations(t)
+}
+
+func TestGerarBrCode_RecebedorNaoEncontrado(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(nil, nil)
+	_, err := svc.GerarBrCode(context.Background(), uint(1), 0, "", "")
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrRecebedorNaoEncontrado, err)
+	repo.AssertExpectations(t)
+}
+
+func TestGerarBrCode_RecebedorBloqueado(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{Id: 1, CpfCnpj: "515.762.030-69", Nome: "joão da silva", TipoChavePix: "CPF", ChavePix: "515.762.030-69", Status: domain.StatusBloqueado}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	_, err := svc.GerarBrCode(context.Background(), uint(1), 0, "", "")
+	assert.Equal(t, domain.ErrRecebedorBloqueado, err)
+	repo.AssertExpectations(t)
+}
+
+func TestGerarBrCode_TxIdInvalido(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{
+		Id:           1,
+		CpfCnpj:      "515.762.030-69",
+		Nome:         "joão da silva",
+		TipoChavePix: "TELEFONE",
+		ChavePix:     "11987654321",
+	}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	_, err := svc.GerarBrCode(context.Background(), uint(1), 0, "pedido-1", "")
+	assert.Error(t, err)
+	assert.Equal(t, brcode.ErrTxIdInvalido, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarRecebedorPorBrCode_Preview(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	codigo, _ := brcode.Payload{Chave: "51576203069", NomeRecebedor: "João da Silva", Cidade: "Aracaju"}.Gerar()
+	recebedor, err := svc.CriarRecebedorPorBrCode(context.Background(), codigo, "", "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.Recebedor{
+		CpfCnpj:      "515.762.030-69",
+		Nome:         "joao da silva",
+		TipoChavePix: domain.Cpf,
+		ChavePix:     "515.762.030-69",
+		Status:       "Rascunho",
+	}, recebedor)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarRecebedorPorBrCode_PreviewInvalido(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	codigo, _ := brcode.Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Flavio Rodolfo", Cidade: "Aracaju"}.Gerar()
+	_, err := svc.CriarRecebedorPorBrCode(context.Background(), codigo, "", "email invalido", true)
+	assert.Equal(t, domain.ErrEmailInvalido, err)
+	_, err = svc.CriarRecebedorPorBrCode(context.Background(), codigo, "111.111.111-11", "", true)
+	assert.Equal(t, domain.ErrCpfInvalido, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarRecebedorPorBrCode_Success(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	codigo, _ := brcode.Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Flavio Rodolfo", Cidade: "Aracaju"}.Gerar()
+	repo.On("BuscarChave", "flavio@transfeera.com").Return("", nil)
+	repo.On("CriarRecebedor", mock.Anything).Return(nil)
+	recebedor, err := svc.CriarRecebedorPorBrCode(context.Background(), codigo, "515.762.030-69", "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Email, recebedor.TipoChavePix)
+	assert.Equal(t, "Rascunho", recebedor.Status)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarRecebedorPorBrCode_CrcInvalido(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	codigo := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3E"
+	_, err := svc.CriarRecebedorPorBrCode(context.Background(), codigo, "", "", true)
+	assert.Error(t, err)
+	assert.Equal(t, brcode.ErrCrcInvalido, err)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarRecebedorPorBrCode_CpfInvalido(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	codigo, _ := brcode.Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Flavio Rodolfo", Cidade: "Aracaju"}.Gerar()
+	_, err := svc.CriarRecebedorPorBrCode(context.Background(), codigo, "", "", false)
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrCpfInvalido, err)
+	repo.AssertExpectations(t)
+}
+
+func TestNormalizarCampos_CpfCnpj(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		result string
+	}{"cpf formatado": {
+		input:  "515.762.030-69",
+		result: "515.762.030-69",
+	},
+		"cpf sem formatacao": {
+			input:  "51576203069",
+			result: "515.762.030-69",
+		},
+		"cnpj numérico formatado": {
+			input:  "41.916.896/0001-30",
+			result: "41.916.896/0001-30",
+		},
+		"cnpj numérico sem formatacao": {
+			input:  "41916896000130",
+			result: "41.916.896/0001-30",
+		},
+		"cnpj alfanumérico formatado": {
+			input:  "12.ABC.345/01DE-35",
+			result: "12.ABC.345/01DE-35",
+		},
+		"cnpj alfanumérico sem formatacao": {
+			input:  "12ABC34501DE35",
+			result: "12.ABC.345/01DE-35",
+		},
+		"cnpj alfanumérico minúsculo": {
+			input:  "12.abc.345/01de-35",
+			result: "12.ABC.345/01DE-35",
+		},
+		"cnpj alfanumérico com espaços": {
+			input:  " 12 ABC 345 01DE 35 ",
+			result: "12.ABC.345/01DE-35",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			recebedor := &domain.Recebedor{CpfCnpj: tt.input}
+			normalizarCampos(recebedor)
+			assert.Equal(t, tt.result, recebedor.CpfCnpj)
+		})
+	}
+}
+
+func TestValidarCpfCnpj(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   error
+	}{"cpf válido": {
+		input: "515.762.030-69",
+	},
+		"cpf inválido": {
+			input: "515.762.030-68",
+			err:   domain.ErrCpfInvalido,
+		},
+		"cnpj numérico válido": {
+			input: "41.916.896/0001-30",
+		},
+		"cnpj numérico inválido": {
+			input: "41.916.896/0001-31",
+			err:   domain.ErrCnpjInvalido,
+		},
+		"cnpj alfanumérico válido": {
+			input: "12.ABC.345/01DE-35",
+		},
+		"cnpj alfanumérico minúsculo válido": {
+			input: "12abc34501de35",
+		},
+		"cnpj alfanumérico inválido": {
+			input: "12.ABC.345/01DE-53",
+			err:   domain.ErrCnpjInvalido,
+		},
+		"cpf com letras": {
+			input: "515.762.030-6A",
+			err:   domain.ErrCpfInvalido,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.err, validarCpfCnpj(tt.input))
+		})
+	}
+}
+
+func TestNormalizarChave_Cnpj(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		result string
+	}{"cnpj numérico": {
+		input:  "41916896000130",
+		result: "41.916.896/0001-30",
+	},
+		"cnpj alfanumérico": {
+			input:  "12ABC34501DE35",
+			result: "12.ABC.345/01DE-35",
+		},
+		"cnpj alfanumérico minúsculo formatado": {
+			input:  "12.abc.345/01de-35",
+			result: "12.ABC.345/01DE-35",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.result, normalizarChave(tt.input, domain.Cnpj))
+			assert.Equal(t, domain.Cnpj, getTipoChave(tt.input))
+		})
+	}
+}
+
+func TestCreateRecebedor_SuccessoCnpjAlfanumerico(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{
+		CpfCnpj:      "12abc34501de35",
+		Nome:         "Transfeera",
+		TipoChavePix: "CNPJ",
+		ChavePix:     "12abc34501de35",
+	}
+	repo.On("BuscarChave", "12.ABC.345/01DE-35").Return("", nil)
+	repo.On("CriarRecebedor", recebedor).Return(nil)
+	err := svc.CriarRecebedor(context.Background(), recebedor)
+	assert.NoError(t, err)
+	assert.Equal(t, "12.ABC.345/01DE-35", recebedor.CpfCnpj)
+	assert.Equal(t, "12.ABC.345/01DE-35", recebedor.ChavePix)
+	repo.AssertExpectations(t)
+}
+
+func TestBuscarRecebedorPorChave_TelefoneQualquerFormato(t *testing.T) {
+	formatos := []string{"79992433805", "5579992433805", "+5579992433805", "+55 (79) 99243-3805"}
+	for _, chave := range formatos {
+		t.Run(chave, func(t *testing.T) {
+			repo := new(MockRepository)
+			svc := &RecebedorService{repo: repo, logger: mockLogger()}
+			recebedores := []*domain.Recebedor{
+				{
+					Id:           57,
+					CpfCnpj:      "081.312.395-00",
+					Nome:         "flavio",
+					TipoChavePix: "TELEFONE",
+					ChavePix:     "+5579992433805",
+					Status:       "Rascunho",
+				},
+			}
+			repo.On("ContarRecebedoresPorCampo", "+5579992433805", "chave_pix").Return(1, nil)
+			repo.On("BuscarRecebedoresPorCampo", "+5579992433805", "chave_pix", 10, 0).Return(recebedores, nil)
+
+			response, err := svc.BuscarRecebedoresPorChave(context.Background(), chave, "", 1)
+			assert.NoError(t, err)
+			assert.Equal(t, "+55 (79) 99243-3805", response.Recebedores[0].ChavePixFormatada)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBuscarRecebedorById_TelefoneFormatado(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{
+		Id:           1,
+		CpfCnpj:      "515.762.030-69",
+		Nome:         "joão da silva",
+		TipoChavePix: "TELEFONE",
+		ChavePix:     "+557932654321",
+	}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	response, err := svc.BuscarRecebedorById(context.Background(), uint(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "+557932654321", response.ChavePix)
+	assert.Equal(t, "+55 (79) 3265-4321", response.ChavePixFormatada)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateRecebedor_TelefoneDddInexistente(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{
+		CpfCnpj:      "515.762.030-69",
+		Nome:         "João da Silva",
+		TipoChavePix: "TELEFONE",
+		ChavePix:     "+5520987654321",
+	}
+	err := svc.CriarRecebedor(context.Background(), recebedor)
 	assert.Error(t, err)
 	assert.Equal(t, domain.ErrChaveInvalida, err)
 	repo.AssertExpectations(t)
+}
+
+func TestBuscarRecebedorPorChave_CpfOuTelefone(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	//chave válida tanto como CPF quanto como celular
+	chave := "11999999975"
+	recebedores := []*domain.Recebedor{
+		{
+			Id:           57,
+			CpfCnpj:      "119.999.999-75",
+			Nome:         "flavio",
+			TipoChavePix: "CPF",
+			ChavePix:     "119.999.999-75",
+		},
+		{
+			Id:           58,
+			CpfCnpj:      "081.312.395-00",
+			Nome:         "camila",
+			TipoChavePix: "TELEFONE",
+			ChavePix:     "+5511999999975",
+		},
+	}
+	chaves := []string{"119.999.999-75", "+5511999999975"}
+	repo.On("ContarRecebedoresPorChaves", chaves).Return(2, nil)
+	repo.On("BuscarRecebedoresPorChaves", chaves, 10, 0).Return(recebedores, nil)
+
+	response, err := svc.BuscarRecebedoresPorChave(context.Background(), chave, "", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Total)
+	assert.Equal(t, []domain.TipoChavePix{domain.Cpf, domain.Telefone}, response.TiposChave)
+	assert.Equal(t, domain.Cpf, response.Recebedores[0].TipoCorrespondente)
+	assert.Equal(t, domain.Telefone, response.Recebedores[1].TipoCorrespondente)
+	repo.AssertExpectations(t)
+}
+
+func TestBuscarRecebedorPorChave_ComTipo(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedores := []*domain.Recebedor{
+		{
+			Id:           58,
+			CpfCnpj:      "081.312.395-00",
+			Nome:         "camila",
+			TipoChavePix: "TELEFONE",
+			ChavePix:     "+5511999999975",
+		},
+	}
+	repo.On("ContarRecebedoresPorCampo", "+5511999999975", "chave_pix").Return(1, nil)
+	repo.On("BuscarRecebedoresPorCampo", "+5511999999975", "chave_pix", 10, 0).Return(recebedores, nil)
+
+	response, err := svc.BuscarRecebedoresPorChave(context.Background(), "11999999975", "TELEFONE", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.TipoChavePix{domain.Telefone}, response.TiposChave)
+	assert.Equal(t, domain.Telefone, response.Recebedores[0].TipoCorrespondente)
+	repo.AssertExpectations(t)
+}
+
+func TestBuscarRecebedorPorChave_TipoNaoCorresponde(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	_, err := svc.BuscarRecebedoresPorChave(context.Background(), "11999999975", "EMAIL", 1)
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrChaveTipoNaoCorresponde, err)
+	repo.AssertExpectations(t)
+}
+
+func TestBuscarRecebedorPorChave_TipoInvalido(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	_, err := svc.BuscarRecebedoresPorChave(context.Background(), "11999999975", "CELULAR", 1)
+	assert.Error(t, err)
+	assert.Equal(t, domain.ErrTipoChaveInvalida, err)
+	repo.AssertExpectations(t)
+}
+
+func TestValidarRecebedor_Success(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{
+		Id:           1,
+		CpfCnpj:      "515.762.030-69",
+		Nome:         "joão da silva",
+		TipoChavePix: "CPF",
+		ChavePix:     "515.762.030-69",
+		Status:       "Rascunho",
+	}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	repo.On("EditarStatusRecebedor", uint(1), "Validado").Return(nil)
+	err := svc.ValidarRecebedor(context.Background(), uint(1))
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestValidarRecebedor_JaValidado(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{Id: 1, Status: "Validado"}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	err := svc.ValidarRecebedor(context.Background(), uint(1))
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
 
+func TestValidarRecebedor_RecebedorNaoEncontrado(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(nil, nil)
+	err := svc.ValidarRecebedor(context.Background(), uint(1))
+	assert.Equal(t, domain.ErrRecebedorNaoEncontrado, err)
+	repo.AssertExpectations(t)
+}
+
+func TestValidarRecebedor_ErroAcessoEditarStatus(t *testing.T) {
+	repo := new(MockRepository)
+	svc := &RecebedorService{repo: repo, logger: mockLogger()}
+	recebedor := &domain.Recebedor{Id: 1, Status: "Rascunho"}
+	repo.On("BuscarRecebedorPorId", uint(1)).Return(recebedor, nil)
+	repo.On("EditarStatusRecebedor", uint(1), "Validado").Return(errDatabaseError)
+	err := svc.ValidarRecebedor(context.Background(), uint(1))
+	assert.Equal(t, errDatabaseError, err)
+	repo.AssertExpectations(t)
+}
+
+func TestBuscarRecebedorPorNome_TamanhoPaginaConfigurado(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewRecebedorService(repo, mockLogger(), ComTamanhoPagina(25))
+	recebedores := []*domain.Recebedor{{Id: 1, Nome: "flavio"}}
+	repo.On("ContarRecebedoresPorCampo", "flavio", "nome").Return(60, nil)
+	repo.On("BuscarRecebedoresPorCampo", "flavio", "nome", 25, 25).Return(recebedores, nil)
+
+	response, err := svc.BuscarRecebedoresPorNome(context.Background(), "flavio", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, response.PorPagina)
+	assert.Equal(t, 3, response.TotalPaginas)
+	repo.AssertExpectations(t)
 }
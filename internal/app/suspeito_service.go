@@ -0,0 +1,407 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"go.uber.org/zap"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	//pontuação de cada suspeita, a pontuação do recebedor é a soma limitada a pontuacaoMaxima
+	pontuacaoDocumentoComNomesDivergentes   = 40
+	pontuacaoNomeSemelhante                 = 30
+	pontuacaoChaveReutilizada               = 20
+	pontuacaoChaveReutilizadaOutroDocumento = 45
+	pontuacaoEmailDescartavel               = 20
+	pontuacaoMaxima                         = 100
+
+	//similaridade mínima entre dois nomes normalizados para serem considerados quase idênticos
+	similaridadeNomes = 0.85
+
+	intervaloDeteccaoPadrao = time.Hour
+	loteRecebedores         = 500 //recebedores consultados por página ao percorrer todos os recebedores
+	tarefaDeteccao          = "deteccao_suspeitos"
+)
+
+// domínios de email temporário mais comuns, substituídos por ComDominiosDescartaveis
+var dominiosDescartaveisPadrao = []string{
+	"mailinator.com", "guerrillamail.com", "sharklasers.com", "10minutemail.com", "tempmail.com",
+	"temp-mail.org", "yopmail.com", "trashmail.com", "getnada.com", "dispostable.com",
+	"maildrop.cc", "throwawaymail.com", "fakeinbox.com", "mailnesia.com", "emailondeck.com",
+}
+
+// SuspeitoService detecta recebedores possivelmente duplicados ou fraudulentos. A detecção
+// percorre todos os recebedores, então é executada periodicamente em segundo plano e o último
+// relatório é retornado pela api. Os nomes semelhantes são comparados apenas entre recebedores
+// com o mesmo primeiro ou último nome
+type SuspeitoService struct {
+	repo          domain.RecebedorRepository
+	historico     domain.HistoricoChaves
+	logger        *zap.Logger
+	intervalo     time.Duration
+	dominios      map[string]bool
+	registrarErro RegistroErros
+
+	mu        sync.Mutex
+	relatorio *domain.RelatorioSuspeitos
+}
+
+// configuração opcional do SuspeitoService
+type OpcaoSuspeito func(*SuspeitoService)
+
+// intervalo entre as detecções executadas em segundo plano
+func ComIntervaloDeteccao(intervalo time.Duration) OpcaoSuspeito {
+	return func(s *SuspeitoService) {
+		s.intervalo = intervalo
+	}
+}
+
+// substitui a lista padrão de domínios de email descartáveis
+func ComDominiosDescartaveis(dominios []string) OpcaoSuspeito {
+	return func(s *SuspeitoService) {
+		s.dominios = conjuntoDominios(dominios)
+	}
+}
+
+// contabiliza os erros da detecção periódica, com a tarefa "deteccao_suspeitos"
+func ComRegistroErrosDeteccao(registrar RegistroErros) OpcaoSuspeito {
+	return func(s *SuspeitoService) {
+		s.registrarErro = registrar
+	}
+}
+
+// o histórico é opcional, sem ele as chaves reutilizadas não são detectadas
+func NewSuspeitoService(repo domain.RecebedorRepository, historico domain.HistoricoChaves, logger *zap.Logger, opcoes ...OpcaoSuspeito) *SuspeitoService {
+	s := &SuspeitoService{
+		repo:          repo,
+		historico:     historico,
+		logger:        logger,
+		intervalo:     intervaloDeteccaoPadrao,
+		dominios:      conjuntoDominios(dominiosDescartaveisPadrao),
+		registrarErro: semRegistroErros,
+	}
+	for _, opcao := range opcoes {
+		opcao(s)
+	}
+	return s
+}
+
+func conjuntoDominios(dominios []string) map[string]bool {
+	conjunto := map[string]bool{}
+	for _, dominio := range dominios {
+		conjunto[strings.ToLower(strings.TrimSpace(dominio))] = true
+	}
+	return conjunto
+}
+
+// executa a detecção periodicamente até o contexto ser cancelado
+func (s *SuspeitoService) Executar(ctx context.Context) {
+	ticker := time.NewTicker(s.intervalo)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Detectar(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Error("detectando recebedores suspeitos", zap.Error(err))
+			s.registrarErro(tarefaDeteccao, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// retorna os suspeitos do último relatório com pontuação a partir da mínima informada,
+// executando a detecção se ela ainda não foi executada
+func (s *SuspeitoService) BuscarSuspeitos(ctx context.Context, pontuacaoMinima int) (*domain.RelatorioSuspeitos, error) {
+	s.mu.Lock()
+	relatorio := s.relatorio
+	s.mu.Unlock()
+	if relatorio == nil {
+		var err error
+		if relatorio, err = s.Detectar(ctx); err != nil {
+			return nil, err
+		}
+	}
+	filtrado := &domain.RelatorioSuspeitos{GeradoEm: relatorio.GeradoEm, Analisados: relatorio.Analisados, Suspeitos: []domain.RecebedorSuspeito{}}
+	for _, suspeito := range relatorio.Suspeitos {
+		if suspeito.Pontuacao >= pontuacaoMinima {
+			filtrado.Suspeitos = append(filtrado.Suspeitos, suspeito)
+		}
+	}
+	return filtrado, nil
+}
+
+// analisa todos os recebedores e armazena o relatório, os suspeitos são ordenados pela
+// maior pontuação
+func (s *SuspeitoService) Detectar(ctx context.Context) (*domain.RelatorioSuspeitos, error) {
+	ctx, span := tracer.Start(ctx, "SuspeitoService.Detectar")
+	defer span.End()
+	recebedores, err := listarRecebedores(ctx, s.repo)
+	if err != nil {
+		return nil, err
+	}
+	deletadas := []domain.ChaveDeletada{}
+	if s.historico != nil {
+		if deletadas, err = s.historico.BuscarChavesDeletadas(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	suspeitas := map[uint][]domain.Suspeita{}
+	adicionar := func(id uint, suspeita domain.Suspeita) {
+		suspeitas[id] = append(suspeitas[id], suspeita)
+	}
+	detectarDocumentosComNomesDivergentes(recebedores, adicionar)
+	detectarNomesSemelhantes(recebedores, adicionar)
+	detectarChavesReutilizadas(recebedores, deletadas, adicionar)
+	s.detectarEmailsDescartaveis(recebedores, adicionar)
+
+	relatorio := &domain.RelatorioSuspeitos{GeradoEm: time.Now().UTC(), Analisados: len(recebedores), Suspeitos: []domain.RecebedorSuspeito{}}
+	for _, recebedor := range recebedores {
+		if len(suspeitas[recebedor.Id]) == 0 {
+			continue
+		}
+		suspeito := domain.RecebedorSuspeito{Recebedor: recebedor, Suspeitas: suspeitas[recebedor.Id]}
+		for _, suspeita := range suspeito.Suspeitas {
+			suspeito.Pontuacao += suspeita.Pontuacao
+		}
+		suspeito.Pontuacao = min(suspeito.Pontuacao, pontuacaoMaxima)
+		relatorio.Suspeitos = append(relatorio.Suspeitos, suspeito)
+	}
+	sort.SliceStable(relatorio.Suspeitos, func(i, j int) bool {
+		return relatorio.Suspeitos[i].Pontuacao > relatorio.Suspeitos[j].Pontuacao
+	})
+
+	s.mu.Lock()
+	s.relatorio = relatorio
+	s.mu.Unlock()
+	s.logger.Info("detecção de recebedores suspeitos concluída", zap.Int("analisados", relatorio.Analisados), zap.Int("suspeitos", len(relatorio.Suspeitos)))
+	return relatorio, nil
+}
+
+// percorre os recebedores de todos os status em páginas, ordenados pelo id
+func listarRecebedores(ctx context.Context, repo domain.RecebedorRepository) ([]*domain.Recebedor, error) {
+	recebedores := []*domain.Recebedor{}
+	for _, status := range []string{domain.StatusRascunho, domain.StatusValidado, domain.StatusBloqueado} {
+		for offset := 0; ; offset += loteRecebedores {
+			pagina, err := repo.BuscarRecebedoresPorCampo(ctx, status, campoStatus, loteRecebedores, offset)
+			if err != nil {
+				return nil, err
+			}
+			recebedores = append(recebedores, pagina...)
+			if len(pagina) < loteRecebedores {
+				break
+			}
+		}
+	}
+	sort.Slice(recebedores, func(i, j int) bool { return recebedores[i].Id < recebedores[j].Id })
+	return recebedores, nil
+}
+
+// nome sem acentos, em minúsculas e com os espaços repetidos removidos
+func normalizarNome(nome string) string {
+	semAcentos, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), nome)
+	if err != nil {
+		semAcentos = nome
+	}
+	return strings.Join(strings.Fields(strings.ToLower(semAcentos)), " ")
+}
+
+func detectarDocumentosComNomesDivergentes(recebedores []*domain.Recebedor, adicionar func(uint, domain.Suspeita)) {
+	porDocumento := map[string][]*domain.Recebedor{}
+	for _, recebedor := range recebedores {
+		documento := removerMascaraCpfCnpj(recebedor.CpfCnpj)
+		porDocumento[documento] = append(porDocumento[documento], recebedor)
+	}
+	for _, grupo := range porDocumento {
+		for _, recebedor := range grupo {
+			nome := normalizarNome(recebedor.Nome)
+			relacionados, nomes := []uint{}, []string{}
+			for _, outro := range grupo {
+				if outroNome := normalizarNome(outro.Nome); outroNome != nome {
+					relacionados = append(relacionados, outro.Id)
+					nomes = append(nomes, outro.Nome)
+				}
+			}
+			if len(relacionados) == 0 {
+				continue
+			}
+			adicionar(recebedor.Id, domain.Suspeita{
+				Tipo:         domain.SuspeitaDocumentoComNomesDivergentes,
+				Pontuacao:    pontuacaoDocumentoComNomesDivergentes,
+				Explicacao:   fmt.Sprintf("cpf/cnpj %s também cadastrado com os nomes: %s", recebedor.CpfCnpj, strings.Join(nomes, ", ")),
+				Relacionados: relacionados,
+			})
+		}
+	}
+}
+
+// os nomes são agrupados pelo primeiro e pelo último nome normalizados e comparados dois a dois
+// apenas dentro de cada grupo, evitando a comparação de todos os pares de recebedores. Nomes que
+// diferem tanto no primeiro quanto no último nome não são comparados. Os pares com tamanhos muito
+// diferentes são descartados antes do cálculo da distância
+func detectarNomesSemelhantes(recebedores []*domain.Recebedor, adicionar func(uint, domain.Suspeita)) {
+	nomes := make([][]rune, len(recebedores))
+	documentos := make([]string, len(recebedores))
+	grupos := map[string][]int{}
+	for i, recebedor := range recebedores {
+		nome := normalizarNome(recebedor.Nome)
+		nomes[i] = []rune(nome)
+		documentos[i] = removerMascaraCpfCnpj(recebedor.CpfCnpj)
+		for _, chave := range chavesGrupoNome(nome) {
+			grupos[chave] = append(grupos[chave], i)
+		}
+	}
+	//pares candidatos ordenados para manter a ordem das suspeitas entre as execuções
+	candidatos := map[[2]int]bool{}
+	for _, grupo := range grupos {
+		for a := range grupo {
+			for b := a + 1; b < len(grupo); b++ {
+				if i, j := grupo[a], grupo[b]; documentos[i] != documentos[j] {
+					candidatos[[2]int{i, j}] = true
+				}
+			}
+		}
+	}
+	pares := make([][2]int, 0, len(candidatos))
+	for par := range candidatos {
+		pares = append(pares, par)
+	}
+	sort.Slice(pares, func(a, b int) bool {
+		if pares[a][0] != pares[b][0] {
+			return pares[a][0] < pares[b][0]
+		}
+		return pares[a][1] < pares[b][1]
+	})
+	for _, par := range pares {
+		i, j := par[0], par[1]
+		maior := max(len(nomes[i]), len(nomes[j]))
+		if maior == 0 || float64(abs(len(nomes[i])-len(nomes[j])))/float64(maior) > 1-similaridadeNomes {
+			continue
+		}
+		similaridade := 1 - float64(distanciaEdicao(nomes[i], nomes[j]))/float64(maior)
+		if similaridade < similaridadeNomes {
+			continue
+		}
+		for _, par := range [][2]*domain.Recebedor{{recebedores[i], recebedores[j]}, {recebedores[j], recebedores[i]}} {
+			adicionar(par[0].Id, domain.Suspeita{
+				Tipo:         domain.SuspeitaNomeSemelhante,
+				Pontuacao:    pontuacaoNomeSemelhante,
+				Explicacao:   fmt.Sprintf("nome %.0f%% semelhante a %q, cadastrado com o cpf/cnpj %s", similaridade*100, par[1].Nome, par[1].CpfCnpj),
+				Relacionados: []uint{par[1].Id},
+			})
+		}
+	}
+}
+
+// grupos do nome normalizado: o primeiro e o último nome
+func chavesGrupoNome(nome string) []string {
+	partes := strings.Fields(nome)
+	if len(partes) == 0 {
+		return nil
+	}
+	chaves := []string{"primeiro:" + partes[0]}
+	if len(partes) > 1 {
+		chaves = append(chaves, "ultimo:"+partes[len(partes)-1])
+	}
+	return chaves
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// distância de Levenshtein entre os nomes
+func distanciaEdicao(a, b []rune) int {
+	anterior := make([]int, len(b)+1)
+	atual := make([]int, len(b)+1)
+	for j := range anterior {
+		anterior[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		atual[0] = i
+		for j := 1; j <= len(b); j++ {
+			custo := 1
+			if a[i-1] == b[j-1] {
+				custo = 0
+			}
+			atual[j] = min(anterior[j]+1, atual[j-1]+1, anterior[j-1]+custo)
+		}
+		anterior, atual = atual, anterior
+	}
+	return anterior[len(b)]
+}
+
+// a chave reutilizada por outro cpf/cnpj pesa mais que a recriação do recebedor pelo mesmo documento
+func detectarChavesReutilizadas(recebedores []*domain.Recebedor, deletadas []domain.ChaveDeletada, adicionar func(uint, domain.Suspeita)) {
+	porChave := map[string][]domain.ChaveDeletada{}
+	for _, deletada := range deletadas {
+		porChave[deletada.ChavePix] = append(porChave[deletada.ChavePix], deletada)
+	}
+	for _, recebedor := range recebedores {
+		for _, deletada := range porChave[recebedor.ChavePix] {
+			if deletada.RecebedorId == recebedor.Id {
+				continue
+			}
+			suspeita := domain.Suspeita{
+				Tipo:         domain.SuspeitaChaveReutilizada,
+				Pontuacao:    pontuacaoChaveReutilizada,
+				Explicacao:   fmt.Sprintf("chave pix pertencia ao recebedor %d (%s), deletado em %s, com o mesmo cpf/cnpj", deletada.RecebedorId, deletada.Nome, deletada.DeletadoEm.Format(time.DateOnly)),
+				Relacionados: []uint{deletada.RecebedorId},
+			}
+			if removerMascaraCpfCnpj(deletada.CpfCnpj) != removerMascaraCpfCnpj(recebedor.CpfCnpj) {
+				suspeita.Pontuacao = pontuacaoChaveReutilizadaOutroDocumento
+				suspeita.Explicacao = fmt.Sprintf("chave pix pertencia ao recebedor %d (%s), deletado em %s, com o cpf/cnpj %s", deletada.RecebedorId, deletada.Nome, deletada.DeletadoEm.Format(time.DateOnly), deletada.CpfCnpj)
+			}
+			adicionar(recebedor.Id, suspeita)
+		}
+	}
+}
+
+// verifica o email do recebedor e a chave pix do tipo email, incluindo os subdomínios
+func (s *SuspeitoService) detectarEmailsDescartaveis(recebedores []*domain.Recebedor, adicionar func(uint, domain.Suspeita)) {
+	for _, recebedor := range recebedores {
+		emails := []string{recebedor.Email}
+		if recebedor.TipoChavePix == domain.Email && !strings.EqualFold(recebedor.ChavePix, recebedor.Email) {
+			emails = append(emails, recebedor.ChavePix)
+		}
+		for _, email := range emails {
+			if dominio := s.dominioDescartavel(email); dominio != "" {
+				adicionar(recebedor.Id, domain.Suspeita{
+					Tipo:       domain.SuspeitaEmailDescartavel,
+					Pontuacao:  pontuacaoEmailDescartavel,
+					Explicacao: fmt.Sprintf("email %s em domínio descartável (%s)", email, dominio),
+				})
+			}
+		}
+	}
+}
+
+// retorna o domínio descartável do email, vazio se o domínio não é descartável
+func (s *SuspeitoService) dominioDescartavel(email string) string {
+	_, dominio, ok := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if !ok {
+		return ""
+	}
+	for dominio != "" {
+		if s.dominios[dominio] {
+			return dominio
+		}
+		_, dominio, _ = strings.Cut(dominio, ".")
+	}
+	return ""
+}
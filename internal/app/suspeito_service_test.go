@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func criarRecebedores(t *testing.T, repo domain.RecebedorRepository, recebedores ...*domain.Recebedor) {
+	for _, recebedor := range recebedores {
+		recebedor.Status = domain.StatusRascunho
+		require.NoError(t, repo.CriarRecebedor(context.Background(), recebedor))
+	}
+}
+
+// suspeitas do recebedor no relatório, indexadas pelo tipo
+func suspeitasDe(relatorio *domain.RelatorioSuspeitos, id uint) map[domain.TipoSuspeita]domain.Suspeita {
+	suspeitas := map[domain.TipoSuspeita]domain.Suspeita{}
+	for _, suspeito := range relatorio.Suspeitos {
+		if suspeito.Recebedor.Id == id {
+			for _, suspeita := range suspeito.Suspeitas {
+				suspeitas[suspeita.Tipo] = suspeita
+			}
+		}
+	}
+	return suspeitas
+}
+
+func TestSuspeitoService_Detectar(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	ana := &domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "ana souza", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}
+	outroNome := &domain.Recebedor{CpfCnpj: "78385283056", Nome: "pedro lima", TipoChavePix: domain.Email, ChavePix: "pedro@transfeera.com"}
+	semelhante := &domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "Ána Souza ", TipoChavePix: domain.Email, ChavePix: "ana.souza@transfeera.com"}
+	descartavel := &domain.Recebedor{CpfCnpj: "994.405.470-49", Nome: "carlos", TipoChavePix: domain.Email, ChavePix: "carlos@mailinator.com", Email: "carlos@eu.yopmail.com"}
+	deletado := &domain.Recebedor{CpfCnpj: "994.405.470-49", Nome: "joana", TipoChavePix: domain.Telefone, ChavePix: "+5511999999999"}
+	criarRecebedores(t, repo, ana, outroNome, semelhante, descartavel, deletado)
+	require.NoError(t, repo.DeletarRecebedor(ctx, deletado.Id))
+	reutilizada := &domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "marcos", TipoChavePix: domain.Telefone, ChavePix: "+5511999999999"}
+	criarRecebedores(t, repo, reutilizada)
+
+	relatorio, err := NewSuspeitoService(repo, repo, zap.NewNop()).Detectar(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, relatorio.Analisados)
+
+	divergente := suspeitasDe(relatorio, ana.Id)[domain.SuspeitaDocumentoComNomesDivergentes]
+	assert.Equal(t, []uint{outroNome.Id}, divergente.Relacionados)
+	assert.Contains(t, divergente.Explicacao, "pedro lima")
+	assert.Contains(t, suspeitasDe(relatorio, outroNome.Id), domain.SuspeitaDocumentoComNomesDivergentes)
+
+	//acentos, maiúsculas e espaços não diferenciam os nomes
+	nome := suspeitasDe(relatorio, semelhante.Id)[domain.SuspeitaNomeSemelhante]
+	assert.Equal(t, []uint{ana.Id}, nome.Relacionados)
+	assert.Contains(t, nome.Explicacao, "100%")
+
+	emails := suspeitasDe(relatorio, descartavel.Id)
+	assert.Equal(t, pontuacaoEmailDescartavel, emails[domain.SuspeitaEmailDescartavel].Pontuacao)
+
+	chave := suspeitasDe(relatorio, reutilizada.Id)[domain.SuspeitaChaveReutilizada]
+	assert.Equal(t, pontuacaoChaveReutilizadaOutroDocumento, chave.Pontuacao)
+	assert.Equal(t, []uint{deletado.Id}, chave.Relacionados)
+	assert.Contains(t, chave.Explicacao, "joana")
+
+	//ordenados pela maior pontuação
+	for i := 1; i < len(relatorio.Suspeitos); i++ {
+		assert.GreaterOrEqual(t, relatorio.Suspeitos[i-1].Pontuacao, relatorio.Suspeitos[i].Pontuacao)
+	}
+}
+
+func TestSuspeitoService_PontuacaoLimitada(t *testing.T) {
+	repo := memoria.NewRecebedorRepository()
+	recebedores := []*domain.Recebedor{
+		{CpfCnpj: "783.852.830-56", Nome: "ana souza", TipoChavePix: domain.Email, ChavePix: "a@mailinator.com", Email: "b@yopmail.com"},
+		{CpfCnpj: "783.852.830-56", Nome: "pedro", TipoChavePix: domain.Email, ChavePix: "pedro@transfeera.com"},
+		{CpfCnpj: "388.361.480-77", Nome: "ana sousa", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"},
+		{CpfCnpj: "994.405.470-49", Nome: "ana souzaa", TipoChavePix: domain.Email, ChavePix: "ana2@transfeera.com"},
+	}
+	criarRecebedores(t, repo, recebedores...)
+
+	relatorio, err := NewSuspeitoService(repo, nil, zap.NewNop()).Detectar(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, recebedores[0].Id, relatorio.Suspeitos[0].Recebedor.Id)
+	assert.Equal(t, pontuacaoMaxima, relatorio.Suspeitos[0].Pontuacao)
+}
+
+func TestSuspeitoService_NomesSemelhantesPorGrupo(t *testing.T) {
+	repo := memoria.NewRecebedorRepository()
+	recebedores := []*domain.Recebedor{
+		{CpfCnpj: "783.852.830-56", Nome: "mariana ferreira", TipoChavePix: domain.Email, ChavePix: "mariana@transfeera.com"},
+		{CpfCnpj: "388.361.480-77", Nome: "marianna ferreira", TipoChavePix: domain.Email, ChavePix: "marianna@transfeera.com"},
+		{CpfCnpj: "994.405.470-49", Nome: "mariana ferreyra", TipoChavePix: domain.Email, ChavePix: "ferreyra@transfeera.com"},
+		//primeiro e último nome diferentes, não é comparado com os demais
+		{CpfCnpj: "515.762.030-69", Nome: "marianaa ferreiraa", TipoChavePix: domain.Email, ChavePix: "outra@transfeera.com"},
+	}
+	criarRecebedores(t, repo, recebedores...)
+
+	relatorio, err := NewSuspeitoService(repo, nil, zap.NewNop()).Detectar(context.Background())
+	require.NoError(t, err)
+	relacionados := map[uint][]uint{}
+	for _, suspeito := range relatorio.Suspeitos {
+		for _, suspeita := range suspeito.Suspeitas {
+			if suspeita.Tipo == domain.SuspeitaNomeSemelhante {
+				relacionados[suspeito.Recebedor.Id] = append(relacionados[suspeito.Recebedor.Id], suspeita.Relacionados...)
+			}
+		}
+	}
+	assert.Equal(t, []uint{recebedores[1].Id, recebedores[2].Id}, relacionados[recebedores[0].Id])
+	assert.Equal(t, []uint{recebedores[0].Id}, relacionados[recebedores[1].Id])
+	assert.Equal(t, []uint{recebedores[0].Id}, relacionados[recebedores[2].Id])
+	assert.Empty(t, relacionados[recebedores[3].Id])
+}
+
+func TestSuspeitoService_BuscarSuspeitos(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	criarRecebedores(t, repo,
+		&domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "ana", TipoChavePix: domain.Email, ChavePix: "ana@empresa.com", Email: "ana@descartavel.io"},
+		&domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "pedro", TipoChavePix: domain.Email, ChavePix: "pedro@mailinator.com"},
+	)
+	service := NewSuspeitoService(repo, nil, zap.NewNop(), ComDominiosDescartaveis([]string{"descartavel.io"}))
+
+	//sem detecção anterior a detecção é executada na consulta
+	relatorio, err := service.BuscarSuspeitos(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, relatorio.Suspeitos, 1)
+	assert.Equal(t, "ana", relatorio.Suspeitos[0].Recebedor.Nome)
+
+	//o relatório armazenado é retornado até a próxima detecção
+	criarRecebedores(t, repo, &domain.Recebedor{CpfCnpj: "994.405.470-49", Nome: "carla", TipoChavePix: domain.Email, ChavePix: "carla@descartavel.io"})
+	novo, err := service.BuscarSuspeitos(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, relatorio.GeradoEm, novo.GeradoEm)
+	assert.Len(t, novo.Suspeitos, 1)
+
+	filtrado, err := service.BuscarSuspeitos(ctx, pontuacaoEmailDescartavel+1)
+	require.NoError(t, err)
+	assert.Empty(t, filtrado.Suspeitos)
+}
+
+func TestDistanciaEdicao(t *testing.T) {
+	assert.Equal(t, 0, distanciaEdicao([]rune("ana"), []rune("ana")))
+	assert.Equal(t, 1, distanciaEdicao([]rune("ana souza"), []rune("ana sousa")))
+	assert.Equal(t, 3, distanciaEdicao([]rune(""), []rune("ana")))
+	assert.Equal(t, "joao da silva", normalizarNome("  João  DA Sílva"))
+}
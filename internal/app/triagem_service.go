@@ -0,0 +1,328 @@
+package app
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"go.uber.org/zap"
+)
+
+const (
+	limiarNomePadrao        = 0.9
+	intervaloTriagemPadrao  = 24 * time.Hour
+	similaridadeDocumento   = 1.0
+	tamanhoMinimoNomeTriado = 3 //nomes menores não são comparados para evitar falsos positivos
+	tarefaTriagem           = "triagem"
+)
+
+// entrada da lista com o cpf/cnpj sem máscara e o nome normalizado para a comparação
+type entradaPreparada struct {
+	lista     string
+	entrada   domain.EntradaListaRestritiva
+	documento string
+	nome      []rune
+	ordenado  []rune
+}
+
+// TriagemService verifica os recebedores nas listas restritivas. As correspondências geram
+// ocorrências pendentes de revisão e o recebedor é bloqueado até que todas as ocorrências
+// sejam liberadas
+type TriagemService struct {
+	ocorrencias   domain.OcorrenciaRepository
+	recebedores   domain.RecebedorRepository
+	fonte         domain.FonteListasRestritivas
+	logger        *zap.Logger
+	limiarNome    float64
+	intervalo     time.Duration
+	registrarErro RegistroErros
+
+	mu       sync.RWMutex
+	entradas []entradaPreparada
+}
+
+// configuração opcional do TriagemService
+type OpcaoTriagem func(*TriagemService)
+
+// similaridade mínima entre o nome do recebedor e o nome da entrada para gerar uma ocorrência
+func ComLimiarNome(limiar float64) OpcaoTriagem {
+	return func(s *TriagemService) {
+		s.limiarNome = limiar
+	}
+}
+
+// intervalo entre as novas triagens dos recebedores existentes
+func ComIntervaloTriagem(intervalo time.Duration) OpcaoTriagem {
+	return func(s *TriagemService) {
+		s.intervalo = intervalo
+	}
+}
+
+// contabiliza os erros da triagem periódica, com a tarefa "triagem"
+func ComRegistroErrosTriagem(registrar RegistroErros) OpcaoTriagem {
+	return func(s *TriagemService) {
+		s.registrarErro = registrar
+	}
+}
+
+// as listas são carregadas por Carregar, antes disso nenhum recebedor possui correspondência
+func NewTriagemService(ocorrencias domain.OcorrenciaRepository, recebedores domain.RecebedorRepository, fonte domain.FonteListasRestritivas, logger *zap.Logger, opcoes ...OpcaoTriagem) *TriagemService {
+	s := &TriagemService{
+		ocorrencias:   ocorrencias,
+		recebedores:   recebedores,
+		fonte:         fonte,
+		logger:        logger,
+		limiarNome:    limiarNomePadrao,
+		intervalo:     intervaloTriagemPadrao,
+		registrarErro: semRegistroErros,
+	}
+	for _, opcao := range opcoes {
+		opcao(s)
+	}
+	return s
+}
+
+// carrega as listas da fonte, em caso de erro as listas carregadas anteriormente são mantidas
+func (s *TriagemService) Carregar(ctx context.Context) error {
+	listas, err := s.fonte.Carregar(ctx)
+	if err != nil {
+		return err
+	}
+	entradas := []entradaPreparada{}
+	for _, lista := range listas {
+		for _, entrada := range lista.Entradas {
+			nome := normalizarNome(entrada.Nome)
+			entradas = append(entradas, entradaPreparada{
+				lista:     lista.Nome,
+				entrada:   entrada,
+				documento: removerMascaraCpfCnpj(entrada.CpfCnpj),
+				nome:      []rune(nome),
+				ordenado:  []rune(ordenarPalavras(nome)),
+			})
+		}
+	}
+	s.mu.Lock()
+	s.entradas = entradas
+	s.mu.Unlock()
+	s.logger.Info("listas restritivas carregadas", zap.Int("listas", len(listas)), zap.Int("entradas", len(entradas)))
+	return nil
+}
+
+// palavras do nome em ordem alfabética, para que a inversão de nome e sobrenome não reduza a similaridade
+func ordenarPalavras(nome string) string {
+	palavras := strings.Fields(nome)
+	sort.Strings(palavras)
+	return strings.Join(palavras, " ")
+}
+
+func similaridade(a, b []rune) float64 {
+	maior := max(len(a), len(b))
+	if maior == 0 {
+		return 0
+	}
+	return 1 - float64(distanciaEdicao(a, b))/float64(maior)
+}
+
+// retorna as correspondências do recebedor nas listas carregadas, o cpf/cnpj deve ser igual e o
+// nome semelhante a partir do limiar configurado
+func (s *TriagemService) Verificar(recebedor *domain.Recebedor) []*domain.OcorrenciaTriagem {
+	documento := removerMascaraCpfCnpj(recebedor.CpfCnpj)
+	normalizado := normalizarNome(recebedor.Nome)
+	nome, ordenado := []rune(normalizado), []rune(ordenarPalavras(normalizado))
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ocorrencias := []*domain.OcorrenciaTriagem{}
+	for _, entrada := range s.entradas {
+		ocorrencia := &domain.OcorrenciaTriagem{RecebedorId: recebedor.Id, Lista: entrada.lista, Entrada: entrada.entrada, Status: domain.OcorrenciaPendente}
+		if entrada.documento != "" && entrada.documento == documento {
+			ocorrencia.Criterio, ocorrencia.Similaridade = domain.CriterioDocumento, similaridadeDocumento
+			ocorrencias = append(ocorrencias, ocorrencia)
+			continue
+		}
+		if len(entrada.nome) < tamanhoMinimoNomeTriado || len(nome) < tamanhoMinimoNomeTriado {
+			continue
+		}
+		semelhanca := max(similaridade(nome, entrada.nome), similaridade(ordenado, entrada.ordenado))
+		if semelhanca >= s.limiarNome {
+			ocorrencia.Criterio, ocorrencia.Similaridade = domain.CriterioNome, semelhanca
+			ocorrencias = append(ocorrencias, ocorrencia)
+		}
+	}
+	return ocorrencias
+}
+
+// o recebedor permanece bloqueado enquanto possuir ocorrências pendentes ou confirmadas
+func possuiRestricao(ocorrencias []*domain.OcorrenciaTriagem) bool {
+	for _, ocorrencia := range ocorrencias {
+		if ocorrencia.Status != domain.OcorrenciaLiberada {
+			return true
+		}
+	}
+	return false
+}
+
+// registra as correspondências que ainda não foram registradas para o recebedor, a mesma
+// entrada não gera uma nova ocorrência mesmo depois de liberada. Retorna todas as ocorrências
+// do recebedor
+func (s *TriagemService) registrar(ctx context.Context, recebedorId uint, correspondencias []*domain.OcorrenciaTriagem) ([]*domain.OcorrenciaTriagem, error) {
+	existentes, err := s.ocorrencias.BuscarOcorrenciasPorRecebedor(ctx, recebedorId)
+	if err != nil {
+		return nil, err
+	}
+	registradas := map[string]bool{}
+	chave := func(o *domain.OcorrenciaTriagem) string {
+		return o.Lista + "\x00" + o.Entrada.CpfCnpj + "\x00" + o.Entrada.Nome
+	}
+	for _, existente := range existentes {
+		registradas[chave(existente)] = true
+	}
+	for _, ocorrencia := range correspondencias {
+		if registradas[chave(ocorrencia)] {
+			continue
+		}
+		ocorrencia.RecebedorId = recebedorId
+		if err := s.ocorrencias.CriarOcorrencia(ctx, ocorrencia); err != nil {
+			return nil, err
+		}
+		registradas[chave(ocorrencia)] = true
+		existentes = append(existentes, ocorrencia)
+		s.logger.Warn("recebedor encontrado em lista restritiva", zap.Uint("recebedor_id", recebedorId),
+			zap.String("lista", ocorrencia.Lista), zap.String("criterio", string(ocorrencia.Criterio)))
+	}
+	return existentes, nil
+}
+
+// verifica o recebedor já cadastrado e o bloqueia se possuir ocorrências pendentes ou confirmadas
+func (s *TriagemService) triar(ctx context.Context, recebedor *domain.Recebedor) error {
+	ocorrencias, err := s.registrar(ctx, recebedor.Id, s.Verificar(recebedor))
+	if err != nil {
+		return err
+	}
+	if !possuiRestricao(ocorrencias) || recebedor.Status == domain.StatusBloqueado {
+		return nil
+	}
+	if err := s.recebedores.EditarStatusRecebedor(ctx, recebedor.Id, domain.StatusBloqueado); err != nil {
+		return err
+	}
+	recebedor.Status = domain.StatusBloqueado
+	s.logger.Info("recebedor bloqueado pela triagem", zap.Uint("recebedor_id", recebedor.Id))
+	return nil
+}
+
+// executa a triagem dos recebedores existentes periodicamente até o contexto ser cancelado,
+// as listas são recarregadas antes de cada nova triagem
+func (s *TriagemService) Executar(ctx context.Context) {
+	ticker := time.NewTicker(s.intervalo)
+	defer ticker.Stop()
+	for {
+		if _, err := s.TriarRecebedores(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Error("triando recebedores", zap.Error(err))
+			s.registrarErro(tarefaTriagem, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := s.Carregar(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Error("recarregando listas restritivas", zap.Error(err))
+			s.registrarErro(tarefaTriagem, err)
+		}
+	}
+}
+
+// verifica todos os recebedores nas listas carregadas e retorna a quantidade de recebedores bloqueados
+func (s *TriagemService) TriarRecebedores(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "TriagemService.TriarRecebedores")
+	defer span.End()
+	recebedores, err := listarRecebedores(ctx, s.recebedores)
+	if err != nil {
+		return 0, err
+	}
+	bloqueados := 0
+	for _, recebedor := range recebedores {
+		status := recebedor.Status
+		if err := s.triar(ctx, recebedor); err != nil {
+			return bloqueados, err
+		}
+		if status != recebedor.Status {
+			bloqueados++
+		}
+	}
+	s.logger.Info("triagem dos recebedores concluída", zap.Int("analisados", len(recebedores)), zap.Int("bloqueados", bloqueados))
+	return bloqueados, nil
+}
+
+// retorna as ocorrências com o status informado, todas se vazio
+func (s *TriagemService) ListarOcorrencias(ctx context.Context, status domain.StatusOcorrencia) ([]*domain.OcorrenciaTriagem, error) {
+	switch status {
+	case "", domain.OcorrenciaPendente, domain.OcorrenciaConfirmada, domain.OcorrenciaLiberada:
+	default:
+		return nil, domain.ErrStatusOcorrenciaInvalido
+	}
+	return s.ocorrencias.ListarOcorrencias(ctx, status)
+}
+
+// retorna as ocorrências do recebedor, retorna erro se o recebedor não existe
+func (s *TriagemService) BuscarOcorrenciasPorRecebedor(ctx context.Context, recebedorId uint) ([]*domain.OcorrenciaTriagem, error) {
+	recebedor, err := s.recebedores.BuscarRecebedorPorId(ctx, recebedorId)
+	if err != nil {
+		return nil, err
+	}
+	if recebedor == nil {
+		return nil, domain.ErrRecebedorNaoEncontrado
+	}
+	return s.ocorrencias.BuscarOcorrenciasPorRecebedor(ctx, recebedorId)
+}
+
+// confirma ou libera a ocorrência pendente. Quando a última restrição do recebedor é liberada ele
+// volta para Rascunho e precisa ser validado novamente
+func (s *TriagemService) RevisarOcorrencia(ctx context.Context, id uint, decisao domain.StatusOcorrencia, observacao string) (*domain.OcorrenciaTriagem, error) {
+	ctx, span := tracer.Start(ctx, "TriagemService.RevisarOcorrencia")
+	defer span.End()
+	if decisao != domain.OcorrenciaConfirmada && decisao != domain.OcorrenciaLiberada {
+		return nil, domain.ErrDecisaoInvalida
+	}
+	ocorrencia, err := s.ocorrencias.BuscarOcorrenciaPorId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ocorrencia == nil {
+		return nil, domain.ErrOcorrenciaNaoEncontrada
+	}
+	if ocorrencia.Status != domain.OcorrenciaPendente {
+		return nil, domain.ErrOcorrenciaJaRevisada
+	}
+	revisadoEm := time.Now().UTC()
+	ocorrencia.Status, ocorrencia.Observacao, ocorrencia.RevisadoEm = decisao, observacao, &revisadoEm
+	if err := s.ocorrencias.RevisarOcorrencia(ctx, ocorrencia); err != nil {
+		return nil, err
+	}
+	s.logger.Info("ocorrência revisada", zap.Uint("ocorrencia_id", id), zap.String("decisao", string(decisao)))
+	if decisao == domain.OcorrenciaConfirmada {
+		return ocorrencia, nil
+	}
+
+	ocorrencias, err := s.ocorrencias.BuscarOcorrenciasPorRecebedor(ctx, ocorrencia.RecebedorId)
+	if err != nil {
+		return nil, err
+	}
+	if possuiRestricao(ocorrencias) {
+		return ocorrencia, nil
+	}
+	//o recebedor pode ter sido deletado após a ocorrência
+	recebedor, err := s.recebedores.BuscarRecebedorPorId(ctx, ocorrencia.RecebedorId)
+	if err != nil {
+		return nil, err
+	}
+	if recebedor != nil && recebedor.Status == domain.StatusBloqueado {
+		if err := s.recebedores.EditarStatusRecebedor(ctx, recebedor.Id, domain.StatusRascunho); err != nil {
+			return nil, err
+		}
+		s.logger.Info("recebedor desbloqueado", zap.Uint("recebedor_id", recebedor.Id))
+	}
+	return ocorrencia, nil
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fonte com as listas em memória, alteradas pelos testes entre as cargas
+type fonteListas struct {
+	listas []domain.ListaRestritiva
+}
+
+func (f *fonteListas) Carregar(ctx context.Context) ([]domain.ListaRestritiva, error) {
+	return f.listas, nil
+}
+
+func novaTriagem(t *testing.T, repo domain.RecebedorRepository, entradas ...domain.EntradaListaRestritiva) (*TriagemService, *fonteListas) {
+	fonte := &fonteListas{listas: []domain.ListaRestritiva{{Nome: "sancoes", Entradas: entradas}}}
+	triagem := NewTriagemService(memoria.NewOcorrenciaRepository(), repo, fonte, zap.NewNop())
+	require.NoError(t, triagem.Carregar(context.Background()))
+	return triagem, fonte
+}
+
+func TestTriagemService_Verificar(t *testing.T) {
+	triagem, _ := novaTriagem(t, memoria.NewRecebedorRepository(),
+		domain.EntradaListaRestritiva{CpfCnpj: "78385283056", Motivo: "fraude"},
+		domain.EntradaListaRestritiva{Nome: "Souza, Ána Maria"},
+		domain.EntradaListaRestritiva{Nome: "pedro"},
+	)
+
+	ocorrencias := triagem.Verificar(&domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "ana maria souza"})
+	require.Len(t, ocorrencias, 2)
+	assert.Equal(t, domain.CriterioDocumento, ocorrencias[0].Criterio)
+	assert.Equal(t, 1.0, ocorrencias[0].Similaridade)
+	assert.Equal(t, "fraude", ocorrencias[0].Entrada.Motivo)
+	//a ordem das palavras, os acentos e a pontuação não impedem a correspondência pelo nome
+	assert.Equal(t, domain.CriterioNome, ocorrencias[1].Criterio)
+	assert.GreaterOrEqual(t, ocorrencias[1].Similaridade, limiarNomePadrao)
+	assert.Equal(t, domain.OcorrenciaPendente, ocorrencias[1].Status)
+
+	assert.Empty(t, triagem.Verificar(&domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "ana souza"}))
+	assert.Len(t, triagem.Verificar(&domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "Pedro"}), 1)
+}
+
+func TestTriagemService_CriarERevisar(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	triagem, _ := novaTriagem(t, repo, domain.EntradaListaRestritiva{Nome: "joão da silva"}, domain.EntradaListaRestritiva{CpfCnpj: "783.852.830-56"})
+	service := NewRecebedorService(repo, zap.NewNop(), ComTriagem(triagem))
+
+	bloqueado := &domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "Joao da Silva", TipoChavePix: domain.Email, ChavePix: "joao@transfeera.com"}
+	require.NoError(t, service.CriarRecebedor(ctx, bloqueado))
+	assert.Equal(t, domain.StatusBloqueado, bloqueado.Status)
+	livre := &domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "maria", TipoChavePix: domain.Email, ChavePix: "maria@transfeera.com"}
+	require.NoError(t, service.CriarRecebedor(ctx, livre))
+	assert.Equal(t, domain.StatusRascunho, livre.Status)
+
+	ocorrencias, err := triagem.BuscarOcorrenciasPorRecebedor(ctx, bloqueado.Id)
+	require.NoError(t, err)
+	require.Len(t, ocorrencias, 2)
+	assert.ErrorIs(t, service.ValidarRecebedor(ctx, bloqueado.Id), domain.ErrRecebedorBloqueado)
+
+	_, err = triagem.RevisarOcorrencia(ctx, ocorrencias[0].Id, domain.OcorrenciaPendente, "")
+	assert.ErrorIs(t, err, domain.ErrDecisaoInvalida)
+	_, err = triagem.RevisarOcorrencia(ctx, ocorrencias[1].Id+10, domain.OcorrenciaLiberada, "")
+	assert.ErrorIs(t, err, domain.ErrOcorrenciaNaoEncontrada)
+
+	//o recebedor permanece bloqueado até a liberação de todas as ocorrências
+	revisada, err := triagem.RevisarOcorrencia(ctx, ocorrencias[0].Id, domain.OcorrenciaLiberada, "homônimo")
+	require.NoError(t, err)
+	assert.Equal(t, "homônimo", revisada.Observacao)
+	assert.NotNil(t, revisada.RevisadoEm)
+	recebedor, err := service.BuscarRecebedorById(ctx, bloqueado.Id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusBloqueado, recebedor.Status)
+
+	_, err = triagem.RevisarOcorrencia(ctx, ocorrencias[1].Id, domain.OcorrenciaLiberada, "documento divergente")
+	require.NoError(t, err)
+	recebedor, err = service.BuscarRecebedorById(ctx, bloqueado.Id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusRascunho, recebedor.Status)
+	assert.NoError(t, service.ValidarRecebedor(ctx, bloqueado.Id))
+
+	_, err = triagem.RevisarOcorrencia(ctx, ocorrencias[1].Id, domain.OcorrenciaConfirmada, "")
+	assert.ErrorIs(t, err, domain.ErrOcorrenciaJaRevisada)
+	_, err = triagem.ListarOcorrencias(ctx, "Revisada")
+	assert.ErrorIs(t, err, domain.ErrStatusOcorrenciaInvalido)
+}
+
+func TestTriagemService_EditarRecebedor(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	triagem, _ := novaTriagem(t, repo, domain.EntradaListaRestritiva{Nome: "carlos pereira"})
+	service := NewRecebedorService(repo, zap.NewNop(), ComTriagem(triagem))
+
+	recebedor := &domain.Recebedor{CpfCnpj: "994.405.470-49", Nome: "carlos", TipoChavePix: domain.Email, ChavePix: "carlos@transfeera.com"}
+	require.NoError(t, service.CriarRecebedor(ctx, recebedor))
+	assert.Equal(t, domain.StatusRascunho, recebedor.Status)
+
+	editado := &domain.Recebedor{Id: recebedor.Id, CpfCnpj: "994.405.470-49", Nome: "Carlos Pereira", TipoChavePix: domain.Email, ChavePix: "carlos.pereira@transfeera.com"}
+	require.NoError(t, service.EditarRecebedor(ctx, editado))
+	assert.Equal(t, domain.StatusBloqueado, editado.Status)
+	salvo, err := service.BuscarRecebedorById(ctx, recebedor.Id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusBloqueado, salvo.Status)
+}
+
+func TestTriagemService_TriarRecebedores(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	validado := &domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "ana souza", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}
+	outro := &domain.Recebedor{CpfCnpj: "388.361.480-77", Nome: "marcos", TipoChavePix: domain.Email, ChavePix: "marcos@transfeera.com"}
+	criarRecebedores(t, repo, validado, outro)
+	require.NoError(t, repo.EditarStatusRecebedor(ctx, validado.Id, domain.StatusValidado))
+
+	triagem, fonte := novaTriagem(t, repo)
+	bloqueados, err := triagem.TriarRecebedores(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, bloqueados)
+
+	//a entrada incluída na lista é encontrada na próxima triagem, inclusive em recebedores validados
+	fonte.listas[0].Entradas = []domain.EntradaListaRestritiva{{CpfCnpj: "78385283056", Motivo: "sanção"}}
+	require.NoError(t, triagem.Carregar(ctx))
+	bloqueados, err = triagem.TriarRecebedores(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, bloqueados)
+	salvo, err := repo.BuscarRecebedorPorId(ctx, validado.Id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusBloqueado, salvo.Status)
+
+	//a mesma entrada não gera uma nova ocorrência
+	_, err = triagem.TriarRecebedores(ctx)
+	require.NoError(t, err)
+	pendentes, err := triagem.ListarOcorrencias(ctx, domain.OcorrenciaPendente)
+	require.NoError(t, err)
+	require.Len(t, pendentes, 1)
+
+	_, err = triagem.RevisarOcorrencia(ctx, pendentes[0].Id, domain.OcorrenciaConfirmada, "sanção confirmada")
+	require.NoError(t, err)
+	salvo, err = repo.BuscarRecebedorPorId(ctx, validado.Id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusBloqueado, salvo.Status)
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 func ValidarCPF(cpf string) bool {
@@ -57,15 +58,18 @@ func valoresIguais(x string) bool {
 	return true
 }
 
+// Retorna true se o CNPJ é válido, tanto no formato numérico quanto no alfanumérico
+// da Receita Federal, onde as 12 primeiras posições podem conter letras
 func ValidarCNPJ(cnpj string) bool {
 	//verifica se o CNPJ está no formato padrão ##.###.###/####-## ou
-	//############## onde # é um digito
-	re := regexp.MustCompile(`^[0-9]{2}[\.]?[0-9]{3}[\.]?[0-9]{3}[\/]?[0-9]{4}[-]?[0-9]{2}$`)
+	//############## onde # é um digito ou letra, exceto os dois digitos verificadores
+	re := regexp.MustCompile(`^[0-9A-Z]{2}[\.]?[0-9A-Z]{3}[\.]?[0-9A-Z]{3}[\/]?[0-9A-Z]{4}[-]?[0-9]{2}$`)
+	cnpj = strings.ToUpper(cnpj)
 	if !re.MatchString(cnpj) {
 		return false
 	}
-	//remove todos não digitos
-	re = regexp.MustCompile(`[^\d]`)
+	//remove todos caracteres de formatação
+	re = regexp.MustCompile(`[^0-9A-Z]`)
 	cnpj = re.ReplaceAllString(cnpj, "")
 
 	// cnpjs que possuem o mesmo digito durante sua estrutura são válidos de acorcdo com o calculo de digito mas
@@ -79,19 +83,22 @@ func ValidarCNPJ(cnpj string) bool {
 }
 
 // calculo digito CNPJ:
-//  1. Multiplicar cada um dos 12 primeiros dígitos por uma sequência de pesos (5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2 para o primeiro dígito,
+//  1. Converter cada caractere no valor do seu código ASCII subtraído de 48, assim os dígitos mantêm
+//     o seu valor e as letras vão de 17 (A) a 42 (Z).
+//  2. Multiplicar cada um dos 12 primeiros valores por uma sequência de pesos (5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2 para o primeiro dígito,
 //     e 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2 para o segundo dígito).
-//  2. Somar os resultados dessas multiplicações.
-//  3. Calcular o módulo 11 da soma obtida.
-//  4. Se o passo 3 for menor que 11 o digito verificador é 0.
-//  5. Senão o resultado é o valor do passo 3 subtraido por 11.
+//  3. Somar os resultados dessas multiplicações.
+//  4. Calcular o módulo 11 da soma obtida.
+//  5. Se o passo 4 for menor que 2 o digito verificador é 0.
+//  6. Senão o resultado é 11 subtraido do valor do passo 4.
 //
 // ver mais em : https://pt.wikipedia.org/wiki/D%C3%ADgito_verificador
-func calcularDigitoVerificadorCnpj(cpf string, multiplicador int) int {
+// e https://www.gov.br/receitafederal/pt-br/acesso-a-informacao/acoes-e-programas/programas-e-atividades/cnpj-alfanumerico
+func calcularDigitoVerificadorCnpj(cnpj string, multiplicador int) int {
 	var soma int
-	for _, s := range cpf {
-		numero, _ := strconv.Atoi(string(s))
-		soma += numero * multiplicador
+	for _, s := range cnpj {
+		valor := int(s) - '0'
+		soma += valor * multiplicador
 		multiplicador--
 		if multiplicador < 2 {
 			multiplicador = 9
@@ -112,11 +119,47 @@ func ValidarEmail(email string) bool {
 	return re.MatchString(email)
 }
 
-// Retorna true se o valor informado é um número de telefone válido nos formatos
-// 55XXXXXXXXXXX ou XXXXXXXXXXX
-func ValidarTelefone(Telefone string) bool {
-	re := regexp.MustCompile(`^((?:\+?55)?)([1-9][0-9])(9[0-9]{8})$`)
-	return re.MatchString(Telefone)
+// DDDs válidos de acordo com o plano de numeração da Anatel
+// veja mais em: https://www.gov.br/anatel/pt-br/regulado/numeracao/plano-de-numeracao-brasileiro
+var ddds = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+// Retorna true se o valor informado é um número de telefone brasileiro válido,
+// celular ou fixo, com DDD existente. Veja NormalizarTelefone para os formatos aceitos
+func ValidarTelefone(telefone string) bool {
+	_, ok := NormalizarTelefone(telefone)
+	return ok
+}
+
+// Converte o telefone para o formato E.164 (+55DDNNNNNNNNN) utilizado pelo DICT.
+// São aceitos os formatos +55DDNNNNNNNNN, 55DDNNNNNNNNN e DDNNNNNNNNN, com ou sem
+// espaços, parênteses, pontos e hífens, onde o número é um celular (9 dígitos iniciando em 9)
+// ou um fixo (8 dígitos iniciando de 2 a 5). Retorna false se o telefone é inválido
+func NormalizarTelefone(telefone string) (string, bool) {
+	telefone = regexp.MustCompile(`[\s().-]`).ReplaceAllString(telefone, "")
+	if strings.HasPrefix(telefone, "+") {
+		if !strings.HasPrefix(telefone, "+55") {
+			return "", false
+		}
+		telefone = telefone[3:]
+	} else if len(telefone) > 11 && strings.HasPrefix(telefone, "55") {
+		telefone = telefone[2:]
+	}
+	re := regexp.MustCompile(`^([1-9][0-9])(9[0-9]{8}|[2-5][0-9]{7})$`)
+	partes := re.FindStringSubmatch(telefone)
+	if partes == nil || !ddds[partes[1]] {
+		return "", false
+	}
+	return "+55" + telefone, true
 }
 
 // Retorna true se o valor está no formato UUID(Universally Unique Identifier)
@@ -69,6 +69,46 @@ func TestValidarCnpj(t *testing.T) {
 			input:  "11111111111111",
 			result: false,
 		},
+		"cnpj alfanumérico válido": {
+			input:  "12.ABC.345/01DE-35",
+			result: true,
+		},
+		"cnpj alfanumérico válido(sem formatacao)": {
+			input:  "12ABC34501DE35",
+			result: true,
+		},
+		"cnpj alfanumérico válido(minúsculo)": {
+			input:  "12.abc.345/01de-35",
+			result: true,
+		},
+		"cnpj alfanumérico válido(apenas letras na raiz)": {
+			input:  "TRANSFEERA0100",
+			result: true,
+		},
+		"cnpj alfanumérico válido(letras e digitos intercalados)": {
+			input:  "A1.B2C.3D4/0001-93",
+			result: true,
+		},
+		"cnpj alfanumérico válido(letras na ordem)": {
+			input:  "00.000.000/ZZZZ-62",
+			result: true,
+		},
+		"cnpj alfanumérico digito verificador inválido": {
+			input:  "12.ABC.345/01DE-36",
+			result: false,
+		},
+		"cnpj alfanumérico com letra no digito verificador": {
+			input:  "12.ABC.345/01DE-3A",
+			result: false,
+		},
+		"cnpj alfanumérico com caractere especial": {
+			input:  "12.AB#.345/01DE-35",
+			result: false,
+		},
+		"cnpj alfanumérico com tamanho errado": {
+			input:  "12ABC34501DE3",
+			result: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -137,6 +177,46 @@ func TestValidarTelefone(t *testing.T) {
 			input:  "998765432",
 			result: false,
 		},
+		"numero válido(formatado)": {
+			input:  "+55 (11) 99876-5432",
+			result: true,
+		},
+		"fixo válido": {
+			input:  "1132654321",
+			result: true,
+		},
+		"fixo válido(com + codigo postal)": {
+			input:  "+551132654321",
+			result: true,
+		},
+		"fixo válido(formatado)": {
+			input:  "(11) 3265-4321",
+			result: true,
+		},
+		"fixo inválido(não inicia de 2 a 5)": {
+			input:  "1172654321",
+			result: false,
+		},
+		"celular inválido(não inicia com 9)": {
+			input:  "11887654321",
+			result: false,
+		},
+		"ddd inexistente": {
+			input:  "20987654321",
+			result: false,
+		},
+		"ddd inexistente(com + codigo postal)": {
+			input:  "+5523987654321",
+			result: false,
+		},
+		"codigo de outro país": {
+			input:  "+15511998765432",
+			result: false,
+		},
+		"celular com ddd 55": {
+			input:  "55987654321",
+			result: true,
+		},
 	}
 
 	for name, tt := range tests {
@@ -192,3 +272,63 @@ func TestValidarEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizarTelefone(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		result string
+		valido bool
+	}{"vazio": {
+		input:  "",
+		valido: false,
+	},
+		"celular sem codigo postal": {
+			input:  "79992433805",
+			result: "+5579992433805",
+			valido: true,
+		},
+		"celular com codigo postal": {
+			input:  "5579992433805",
+			result: "+5579992433805",
+			valido: true,
+		},
+		"celular e164": {
+			input:  "+5579992433805",
+			result: "+5579992433805",
+			valido: true,
+		},
+		"celular formatado": {
+			input:  "+55 (79) 99243-3805",
+			result: "+5579992433805",
+			valido: true,
+		},
+		"fixo com codigo postal": {
+			input:  "557932654321",
+			result: "+557932654321",
+			valido: true,
+		},
+		"fixo formatado": {
+			input:  "(79) 3265-4321",
+			result: "+557932654321",
+			valido: true,
+		},
+		"celular com ddd 55": {
+			input:  "55987654321",
+			result: "+5555987654321",
+			valido: true,
+		},
+		"ddd inexistente": {
+			input:  "+5520987654321",
+			valido: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, valido := NormalizarTelefone(tt.input)
+			if valido != tt.valido || result != tt.result {
+				t.Errorf("esperado %v %v, obtido %v %v", tt.result, tt.valido, result, valido)
+			}
+		})
+	}
+}
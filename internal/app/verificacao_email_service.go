@@ -0,0 +1,240 @@
+package app
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"go.uber.org/zap"
+)
+
+const (
+	validadeTokenPadrao     = 24 * time.Hour
+	urlVerificacaoPadrao    = "http://localhost:8080/api/v1/recebedores/verificar-email"
+	tamanhoFilaEmails       = 100
+	tentativasEnvioEmail    = 3
+	backoffEnvioEmail       = 5 * time.Second
+	tamanhoNonceVerificacao = 16
+	assuntoVerificacao      = "Verificação do email do recebedor"
+	tarefaVerificacaoEmail  = "verificacao_email"
+)
+
+// conteúdo assinado do token de verificação
+type tokenVerificacao struct {
+	RecebedorId uint      `json:"recebedor_id"`
+	Email       string    `json:"email"`
+	ExpiraEm    time.Time `json:"expira_em"`
+	Nonce       string    `json:"nonce"`
+}
+
+// VerificacaoEmailService envia ao email do recebedor um link com um token assinado e marca o
+// email como verificado quando o token é apresentado. O token é de uso único: o repositório só
+// marca a verificação se o email ainda não foi verificado e é o mesmo do token
+type VerificacaoEmailService struct {
+	repo          domain.RecebedorRepository
+	mailer        domain.Mailer
+	segredo       []byte
+	logger        *zap.Logger
+	validade      time.Duration
+	url           string
+	backoff       time.Duration
+	fila          chan domain.MensagemEmail
+	registrarErro RegistroErros
+}
+
+// configuração opcional do VerificacaoEmailService
+type OpcaoVerificacaoEmail func(*VerificacaoEmailService)
+
+// tempo em que o token enviado pode ser usado
+func ComValidadeToken(validade time.Duration) OpcaoVerificacaoEmail {
+	return func(s *VerificacaoEmailService) {
+		s.validade = validade
+	}
+}
+
+// endereço do link de verificação, o token é incluído no parâmetro token
+func ComUrlVerificacao(url string) OpcaoVerificacaoEmail {
+	return func(s *VerificacaoEmailService) {
+		s.url = url
+	}
+}
+
+// contabiliza os erros na geração dos tokens e os envios que esgotaram as tentativas, com a
+// tarefa "verificacao_email"
+func ComRegistroErrosEmail(registrar RegistroErros) OpcaoVerificacaoEmail {
+	return func(s *VerificacaoEmailService) {
+		s.registrarErro = registrar
+	}
+}
+
+// os emails são enviados por Executar, antes disso as solicitações aguardam na fila
+func NewVerificacaoEmailService(repo domain.RecebedorRepository, mailer domain.Mailer, segredo []byte, logger *zap.Logger, opcoes ...OpcaoVerificacaoEmail) *VerificacaoEmailService {
+	s := &VerificacaoEmailService{
+		repo:          repo,
+		mailer:        mailer,
+		segredo:       segredo,
+		logger:        logger,
+		validade:      validadeTokenPadrao,
+		url:           urlVerificacaoPadrao,
+		backoff:       backoffEnvioEmail,
+		fila:          make(chan domain.MensagemEmail, tamanhoFilaEmails),
+		registrarErro: semRegistroErros,
+	}
+	for _, opcao := range opcoes {
+		opcao(s)
+	}
+	return s
+}
+
+// logger com os identificadores do trace da operação
+func (s *VerificacaoEmailService) log(ctx context.Context) *zap.Logger {
+	return rastreamento.Logger(ctx, s.logger)
+}
+
+// gera o token de verificação do email atual do recebedor e agenda o envio, sem aguardar o
+// servidor de email. Com a fila cheia o envio é descartado e o recebedor deve solicitar um novo
+// envio alterando o email
+func (s *VerificacaoEmailService) Solicitar(ctx context.Context, recebedor *domain.Recebedor) {
+	if recebedor.Email == "" {
+		return
+	}
+	token, err := s.gerarToken(recebedor.Id, recebedor.Email, time.Now().Add(s.validade))
+	if err != nil {
+		s.log(ctx).Error("gerando token de verificação", zap.Error(err), zap.Uint("recebedor_id", recebedor.Id))
+		s.registrarErro(tarefaVerificacaoEmail, err)
+		return
+	}
+	link := s.url + "?token=" + url.QueryEscape(token)
+	mensagem := domain.MensagemEmail{
+		Para:    recebedor.Email,
+		Assunto: assuntoVerificacao,
+		Corpo: fmt.Sprintf("Olá, %s.\n\nPara confirmar o email do cadastro acesse o link abaixo em até %s:\n\n%s\n",
+			recebedor.Nome, s.validade, link),
+	}
+	select {
+	case s.fila <- mensagem:
+	default:
+		s.log(ctx).Error("fila de emails cheia, verificação não enviada", zap.Uint("recebedor_id", recebedor.Id))
+	}
+}
+
+// envia os emails da fila até o cancelamento do contexto, cada email é reenviado com backoff
+// exponencial até esgotar as tentativas
+func (s *VerificacaoEmailService) Executar(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case mensagem := <-s.fila:
+			s.enviar(ctx, mensagem)
+		}
+	}
+}
+
+func (s *VerificacaoEmailService) enviar(ctx context.Context, mensagem domain.MensagemEmail) {
+	for tentativa := 1; ; tentativa++ {
+		err := s.mailer.Enviar(ctx, mensagem)
+		if err == nil {
+			return
+		}
+		if tentativa == tentativasEnvioEmail || ctx.Err() != nil {
+			s.logger.Error("enviando email de verificação", zap.Error(err), zap.Int("tentativas", tentativa))
+			//o envio interrompido pelo encerramento da aplicação não é contabilizado
+			if ctx.Err() == nil {
+				s.registrarErro(tarefaVerificacaoEmail, err)
+			}
+			return
+		}
+		//backoff exponencial: backoff, 2*backoff, 4*backoff...
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.backoff << (tentativa - 1)):
+		}
+	}
+}
+
+// marca o email do recebedor como verificado e retorna o recebedor atualizado. Retorna erro se
+// o token é inválido, expirou, se o email do recebedor foi alterado ou se já foi verificado
+func (s *VerificacaoEmailService) Verificar(ctx context.Context, token string) (*domain.Recebedor, error) {
+	ctx, span := tracer.Start(ctx, "VerificacaoEmailService.Verificar")
+	defer span.End()
+	conteudo, err := s.lerToken(token)
+	if err != nil {
+		return nil, err
+	}
+	agora := time.Now().UTC()
+	if agora.After(conteudo.ExpiraEm) {
+		return nil, domain.ErrTokenVerificacaoExpirado
+	}
+	verificado, err := s.repo.VerificarEmailRecebedor(ctx, conteudo.RecebedorId, conteudo.Email, agora)
+	if err != nil {
+		s.log(ctx).Error("verificando email do recebedor", zap.Error(err), zap.Uint("recebedor_id", conteudo.RecebedorId))
+		return nil, err
+	}
+	recebedor, err := s.repo.BuscarRecebedorPorId(ctx, conteudo.RecebedorId)
+	if err != nil {
+		s.log(ctx).Error("consultando recebedor", zap.Error(err))
+		return nil, err
+	}
+	//o recebedor foi deletado ou o email foi alterado depois do envio do token
+	if recebedor == nil || recebedor.Email != conteudo.Email {
+		return nil, domain.ErrTokenVerificacaoInvalido
+	}
+	if !verificado {
+		return nil, domain.ErrEmailJaVerificado
+	}
+	s.log(ctx).Info("email do recebedor verificado", zap.Uint("recebedor_id", recebedor.Id))
+	formatarChavesTelefone(recebedor)
+	return recebedor, nil
+}
+
+// token no formato <conteúdo>.<assinatura>, ambos em base64 url sem padding
+func (s *VerificacaoEmailService) gerarToken(id uint, email string, expiraEm time.Time) (string, error) {
+	nonce := make([]byte, tamanhoNonceVerificacao)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	conteudo, err := json.Marshal(tokenVerificacao{RecebedorId: id, Email: email, ExpiraEm: expiraEm.UTC(), Nonce: hex.EncodeToString(nonce)})
+	if err != nil {
+		return "", err
+	}
+	codificado := base64.RawURLEncoding.EncodeToString(conteudo)
+	return codificado + "." + base64.RawURLEncoding.EncodeToString(s.assinar(codificado)), nil
+}
+
+func (s *VerificacaoEmailService) lerToken(token string) (*tokenVerificacao, error) {
+	codificado, assinatura, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, domain.ErrTokenVerificacaoInvalido
+	}
+	recebida, err := base64.RawURLEncoding.DecodeString(assinatura)
+	if err != nil || !hmac.Equal(recebida, s.assinar(codificado)) {
+		return nil, domain.ErrTokenVerificacaoInvalido
+	}
+	conteudo, err := base64.RawURLEncoding.DecodeString(codificado)
+	if err != nil {
+		return nil, domain.ErrTokenVerificacaoInvalido
+	}
+	var t tokenVerificacao
+	if err := json.Unmarshal(conteudo, &t); err != nil {
+		return nil, domain.ErrTokenVerificacaoInvalido
+	}
+	return &t, nil
+}
+
+func (s *VerificacaoEmailService) assinar(conteudo string) []byte {
+	mac := hmac.New(sha256.New, s.segredo)
+	mac.Write([]byte(conteudo))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var regexLinkVerificacao = regexp.MustCompile(`https?://\S+`)
+
+// mailer que falha nas primeiras tentativas e guarda as mensagens enviadas
+type mailerFalso struct {
+	mu       sync.Mutex
+	falhas   int
+	enviados []domain.MensagemEmail
+}
+
+func (m *mailerFalso) Enviar(ctx context.Context, mensagem domain.MensagemEmail) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.falhas > 0 {
+		m.falhas--
+		return errors.New("servidor indisponível")
+	}
+	m.enviados = append(m.enviados, mensagem)
+	return nil
+}
+
+// retira a próxima mensagem da fila e retorna o token do link
+func tokenDaFila(t *testing.T, s *VerificacaoEmailService) (domain.MensagemEmail, string) {
+	select {
+	case mensagem := <-s.fila:
+		link, err := url.Parse(regexLinkVerificacao.FindString(mensagem.Corpo))
+		require.NoError(t, err)
+		return mensagem, link.Query().Get("token")
+	default:
+		t.Fatal("nenhum email na fila")
+		return domain.MensagemEmail{}, ""
+	}
+}
+
+func TestVerificacaoEmailService_Verificar(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	emails := NewVerificacaoEmailService(repo, &mailerFalso{}, []byte("segredo"), zap.NewNop())
+	service := NewRecebedorService(repo, zap.NewNop(), ComVerificacaoEmail(emails))
+
+	recebedor := &domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "ana souza", Email: "Ana@Transfeera.com", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}
+	require.NoError(t, service.CriarRecebedor(ctx, recebedor))
+	mensagem, token := tokenDaFila(t, emails)
+	assert.Equal(t, "ana@transfeera.com", mensagem.Para)
+
+	_, err := emails.Verificar(ctx, token+"a")
+	assert.ErrorIs(t, err, domain.ErrTokenVerificacaoInvalido)
+	_, err = emails.Verificar(ctx, "invalido")
+	assert.ErrorIs(t, err, domain.ErrTokenVerificacaoInvalido)
+
+	verificado, err := emails.Verificar(ctx, token)
+	require.NoError(t, err)
+	require.NotNil(t, verificado.EmailVerificadoEm)
+	//o token é de uso único
+	_, err = emails.Verificar(ctx, token)
+	assert.ErrorIs(t, err, domain.ErrEmailJaVerificado)
+
+	//a alteração do email remove a verificação e invalida o token anterior
+	require.NoError(t, service.EditarEmailRecebedor(ctx, recebedor.Id, "ana.souza@transfeera.com"))
+	salvo, err := service.BuscarRecebedorById(ctx, recebedor.Id)
+	require.NoError(t, err)
+	assert.Nil(t, salvo.EmailVerificadoEm)
+	_, novoToken := tokenDaFila(t, emails)
+	_, err = emails.Verificar(ctx, token)
+	assert.ErrorIs(t, err, domain.ErrTokenVerificacaoInvalido)
+	_, err = emails.Verificar(ctx, novoToken)
+	assert.NoError(t, err)
+
+	//o mesmo email não gera uma nova verificação
+	require.NoError(t, service.EditarEmailRecebedor(ctx, recebedor.Id, "ana.souza@transfeera.com"))
+	assert.Empty(t, emails.fila)
+}
+
+func TestVerificacaoEmailService_TokenExpirado(t *testing.T) {
+	ctx := context.Background()
+	repo := memoria.NewRecebedorRepository()
+	recebedor := &domain.Recebedor{CpfCnpj: "783.852.830-56", Nome: "ana souza", Email: "ana@transfeera.com", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}
+	criarRecebedores(t, repo, recebedor)
+	emails := NewVerificacaoEmailService(repo, &mailerFalso{}, []byte("segredo"), zap.NewNop())
+
+	token, err := emails.gerarToken(recebedor.Id, recebedor.Email, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	_, err = emails.Verificar(ctx, token)
+	assert.ErrorIs(t, err, domain.ErrTokenVerificacaoExpirado)
+
+	//o token assinado com outro segredo é inválido
+	outro := NewVerificacaoEmailService(repo, &mailerFalso{}, []byte("outro segredo"), zap.NewNop())
+	token, err = outro.gerarToken(recebedor.Id, recebedor.Email, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = emails.Verificar(ctx, token)
+	assert.ErrorIs(t, err, domain.ErrTokenVerificacaoInvalido)
+}
+
+func TestVerificacaoEmailService_Executar(t *testing.T) {
+	mailer := &mailerFalso{falhas: 2}
+	emails := NewVerificacaoEmailService(memoria.NewRecebedorRepository(), mailer, []byte("segredo"), zap.NewNop(),
+		ComUrlVerificacao("https://transfeera.com/verificar"))
+	emails.backoff = time.Millisecond
+	emails.Solicitar(context.Background(), &domain.Recebedor{Id: 1, Nome: "ana", Email: "ana@transfeera.com"})
+	//recebedor sem email não recebe a verificação
+	emails.Solicitar(context.Background(), &domain.Recebedor{Id: 2, Nome: "pedro"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go emails.Executar(ctx)
+	require.Eventually(t, func() bool {
+		mailer.mu.Lock()
+		defer mailer.mu.Unlock()
+		return len(mailer.enviados) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Contains(t, mailer.enviados[0].Corpo, "https://transfeera.com/verificar?token=")
+}
+
+func TestVerificacaoEmailService_RegistraErroEnvio(t *testing.T) {
+	var mu sync.Mutex
+	tarefas := []string{}
+	emails := NewVerificacaoEmailService(memoria.NewRecebedorRepository(), &mailerFalso{falhas: tentativasEnvioEmail}, []byte("segredo"), zap.NewNop(),
+		ComRegistroErrosEmail(func(tarefa string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			tarefas = append(tarefas, tarefa)
+		}))
+	emails.backoff = time.Millisecond
+	emails.Solicitar(context.Background(), &domain.Recebedor{Id: 1, Nome: "ana", Email: "ana@transfeera.com"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go emails.Executar(ctx)
+	//o erro é contabilizado apenas quando as tentativas se esgotam
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(tarefas) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, tarefaVerificacaoEmail, tarefas[0])
+}
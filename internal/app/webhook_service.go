@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"go.uber.org/zap"
+)
+
+const tamanhoSegredo = 32 //bytes do segredo gerado quando não informado
+
+type WebhookService struct {
+	repo   domain.WebhookRepository
+	logger *zap.Logger
+}
+
+func NewWebhookService(repo domain.WebhookRepository, logger *zap.Logger) *WebhookService {
+	return &WebhookService{repo: repo, logger: logger}
+}
+
+// cadastra a inscrição de um webhook, retorna erro se a url ou algum dos eventos é inválido.
+// Se o segredo não for informado um segredo aleatório é gerado e retornado no webhook
+func (s *WebhookService) CriarWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	u, err := url.ParseRequestURI(webhook.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return domain.ErrUrlWebhookInvalida
+	}
+	for _, evento := range webhook.Eventos {
+		if !isEventoValido(evento) {
+			return domain.ErrEventoInvalido
+		}
+	}
+	if webhook.Eventos == nil {
+		webhook.Eventos = []domain.TipoEvento{}
+	}
+	if webhook.Segredo == "" {
+		segredo := make([]byte, tamanhoSegredo)
+		if _, err := rand.Read(segredo); err != nil {
+			s.logger.Error("gerando segredo do webhook", zap.Error(err))
+			return err
+		}
+		webhook.Segredo = hex.EncodeToString(segredo)
+	}
+	if err := s.repo.CriarWebhook(ctx, webhook); err != nil {
+		s.logger.Error("salvando webhook", zap.Error(err))
+		return err
+	}
+	s.logger.Info("webhook criado com sucesso", zap.Uint("webhook_id", webhook.Id))
+	return nil
+}
+
+// retorna os webhooks cadastrados, sem os segredos
+func (s *WebhookService) ListarWebhooks(ctx context.Context) ([]*domain.Webhook, error) {
+	webhooks, err := s.repo.ListarWebhooks(ctx)
+	if err != nil {
+		s.logger.Error("consultando webhooks", zap.Error(err))
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		webhook.Segredo = ""
+	}
+	return webhooks, nil
+}
+
+// deleta o webhook e as suas entregas, retorna erro em caso de webhook inexistente
+func (s *WebhookService) DeletarWebhook(ctx context.Context, id uint) error {
+	webhook, err := s.repo.BuscarWebhookPorId(ctx, id)
+	if err != nil {
+		s.logger.Error("consultando webhook", zap.Error(err))
+		return err
+	}
+	if webhook == nil {
+		return domain.ErrWebhookNaoEncontrado
+	}
+	if err := s.repo.DeletarWebhook(ctx, id); err != nil {
+		s.logger.Error("deletando webhook", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// registra uma entrega pendente do evento para cada webhook inscrito no seu tipo,
+// o envio é realizado de forma assíncrona pelo despachante de webhooks. O relay do outbox
+// publica o evento novamente quando outro publicador falha, as entregas já registradas são mantidas
+func (s *WebhookService) Publicar(ctx context.Context, evento domain.Evento) error {
+	webhooks, err := s.repo.BuscarWebhooksPorEvento(ctx, evento.Tipo)
+	if err != nil {
+		s.logger.Error("consultando webhooks do evento", zap.Error(err), zap.String("evento", string(evento.Tipo)))
+		return err
+	}
+	for _, webhook := range webhooks {
+		entrega := &domain.EntregaWebhook{
+			WebhookId:        webhook.Id,
+			Evento:           evento,
+			Status:           domain.EntregaPendente,
+			ProximaTentativa: time.Now().UTC(),
+		}
+		if err := s.repo.CriarEntrega(ctx, entrega); err != nil {
+			s.logger.Error("salvando entrega de webhook", zap.Error(err), zap.Uint("webhook_id", webhook.Id))
+			return err
+		}
+	}
+	return nil
+}
+
+// retorna as entregas que esgotaram as tentativas de envio
+func (s *WebhookService) ListarEntregasComFalha(ctx context.Context) ([]*domain.EntregaWebhook, error) {
+	entregas, err := s.repo.ListarEntregasComFalha(ctx)
+	if err != nil {
+		s.logger.Error("consultando entregas com falha", zap.Error(err))
+		return nil, err
+	}
+	return entregas, nil
+}
+
+// agenda novamente o envio de uma entrega, zerando as tentativas realizadas.
+// Retorna erro em caso de entrega inexistente
+func (s *WebhookService) ReenviarEntrega(ctx context.Context, id uint) error {
+	entrega, err := s.repo.BuscarEntregaPorId(ctx, id)
+	if err != nil {
+		s.logger.Error("consultando entrega de webhook", zap.Error(err))
+		return err
+	}
+	if entrega == nil {
+		return domain.ErrEntregaNaoEncontrada
+	}
+	entrega.Status = domain.EntregaPendente
+	entrega.Tentativas = 0
+	entrega.ProximaTentativa = time.Now().UTC()
+	if err := s.repo.AtualizarEntrega(ctx, entrega); err != nil {
+		s.logger.Error("reagendando entrega de webhook", zap.Error(err))
+		return err
+	}
+	s.logger.Info("entrega de webhook reagendada", zap.Uint("entrega_id", id))
+	return nil
+}
+
+// retorna true se é um tipo de evento emitido pela aplicação
+func isEventoValido(tipo domain.TipoEvento) bool {
+	for _, t := range domain.TiposEvento {
+		if t == tipo {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepository) CriarWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	args := m.Called(webhook)
+	return args.Error(0)
+}
+func (m *MockWebhookRepository) BuscarWebhookPorId(ctx context.Context, id uint) (*domain.Webhook, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Webhook), args.Error(1)
+}
+func (m *MockWebhookRepository) ListarWebhooks(ctx context.Context) ([]*domain.Webhook, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+func (m *MockWebhookRepository) BuscarWebhooksPorEvento(ctx context.Context, tipo domain.TipoEvento) ([]*domain.Webhook, error) {
+	args := m.Called(tipo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Webhook), args.Error(1)
+}
+func (m *MockWebhookRepository) DeletarWebhook(ctx context.Context, id uint) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+func (m *MockWebhookRepository) CriarEntrega(ctx context.Context, entrega *domain.EntregaWebhook) error {
+	args := m.Called(entrega)
+	return args.Error(0)
+}
+func (m *MockWebhookRepository) BuscarEntregaPorId(ctx context.Context, id uint) (*domain.EntregaWebhook, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EntregaWebhook), args.Error(1)
+}
+func (m *MockWebhookRepository) ReservarEntregasPendentes(ctx context.Context, limite int, reserva time.Duration) ([]*domain.EntregaWebhook, error) {
+	args := m.Called(limite, reserva)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.EntregaWebhook), args.Error(1)
+}
+func (m *MockWebhookRepository) AtualizarEntrega(ctx context.Context, entrega *domain.EntregaWebhook) error {
+	args := m.Called(entrega)
+	return args.Error(0)
+}
+func (m *MockWebhookRepository) ListarEntregasComFalha(ctx context.Context) ([]*domain.EntregaWebhook, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.EntregaWebhook), args.Error(1)
+}
+
+func TestCriarWebhook_Success(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	webhook := &domain.Webhook{
+		Url:     "https://erp.example.com/webhooks",
+		Eventos: []domain.TipoEvento{domain.EventoRecebedorCriado},
+		Segredo: "segredo",
+	}
+	repo.On("CriarWebhook", webhook).Return(nil)
+	err := svc.CriarWebhook(context.Background(), webhook)
+	assert.NoError(t, err)
+	assert.Equal(t, "segredo", webhook.Segredo)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarWebhook_GeraSegredo(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	webhook := &domain.Webhook{Url: "http://localhost:9000/webhooks"}
+	repo.On("CriarWebhook", webhook).Return(nil)
+	err := svc.CriarWebhook(context.Background(), webhook)
+	assert.NoError(t, err)
+	assert.Len(t, webhook.Segredo, 2*tamanhoSegredo)
+	assert.Equal(t, []domain.TipoEvento{}, webhook.Eventos)
+	repo.AssertExpectations(t)
+}
+
+func TestCriarWebhook_UrlInvalida(t *testing.T) {
+	urls := []string{"", "erp.example.com/webhooks", "ftp://erp.example.com", "https://"}
+	for _, url := range urls {
+		t.Run(url, func(t *testing.T) {
+			repo := new(MockWebhookRepository)
+			svc := &WebhookService{repo: repo, logger: mockLogger()}
+			err := svc.CriarWebhook(context.Background(), &domain.Webhook{Url: url})
+			assert.Equal(t, domain.ErrUrlWebhookInvalida, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCriarWebhook_EventoInvalido(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	webhook := &domain.Webhook{
+		Url:     "https://erp.example.com/webhooks",
+		Eventos: []domain.TipoEvento{"recebedor.inexistente"},
+	}
+	err := svc.CriarWebhook(context.Background(), webhook)
+	assert.Equal(t, domain.ErrEventoInvalido, err)
+	repo.AssertExpectations(t)
+}
+
+func TestListarWebhooks_OmiteSegredo(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	repo.On("ListarWebhooks").Return([]*domain.Webhook{{Id: 1, Url: "https://erp.example.com", Segredo: "segredo"}}, nil)
+	webhooks, err := svc.ListarWebhooks(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "", webhooks[0].Segredo)
+	repo.AssertExpectations(t)
+}
+
+func TestDeletarWebhook_NaoEncontrado(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	repo.On("BuscarWebhookPorId", uint(1)).Return(nil, nil)
+	err := svc.DeletarWebhook(context.Background(), uint(1))
+	assert.Equal(t, domain.ErrWebhookNaoEncontrado, err)
+	repo.AssertExpectations(t)
+}
+
+func TestPublicar_CriaEntregaPorWebhook(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	evento := domain.Evento{Id: "1", Tipo: domain.EventoRecebedorCriado, Recebedor: &domain.Recebedor{Id: 1}}
+	repo.On("BuscarWebhooksPorEvento", domain.EventoRecebedorCriado).Return([]*domain.Webhook{{Id: 1}, {Id: 2}}, nil)
+	repo.On("CriarEntrega", mock.MatchedBy(func(e *domain.EntregaWebhook) bool {
+		return e.Status == domain.EntregaPendente && e.Evento.Id == "1"
+	})).Return(nil).Twice()
+	err := svc.Publicar(context.Background(), evento)
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestPublicar_ErroConsultaWebhooks(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	repo.On("BuscarWebhooksPorEvento", domain.EventoRecebedorDeletado).Return(nil, errDatabaseError)
+	err := svc.Publicar(context.Background(), domain.Evento{Tipo: domain.EventoRecebedorDeletado})
+	assert.Equal(t, errDatabaseError, err)
+	repo.AssertExpectations(t)
+}
+
+func TestReenviarEntrega_Success(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	entrega := &domain.EntregaWebhook{Id: 1, Status: domain.EntregaFalha, Tentativas: 8}
+	repo.On("BuscarEntregaPorId", uint(1)).Return(entrega, nil)
+	repo.On("AtualizarEntrega", entrega).Return(nil)
+	err := svc.ReenviarEntrega(context.Background(), uint(1))
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EntregaPendente, entrega.Status)
+	assert.Equal(t, 0, entrega.Tentativas)
+	repo.AssertExpectations(t)
+}
+
+func TestReenviarEntrega_NaoEncontrada(t *testing.T) {
+	repo := new(MockWebhookRepository)
+	svc := &WebhookService{repo: repo, logger: mockLogger()}
+	repo.On("BuscarEntregaPorId", uint(1)).Return(nil, nil)
+	err := svc.ReenviarEntrega(context.Background(), uint(1))
+	assert.Equal(t, domain.ErrEntregaNaoEncontrada, err)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,276 @@
+package brcode
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// identificadores dos campos do padrão EMV-MPM utilizados pelo BR Code
+// veja mais em: https://www.bcb.gov.br/estabilidadefinanceira/pix (Manual de Padrões para Iniciação do Pix)
+const (
+	idPayloadFormatIndicator     = "00"
+	idMerchantAccountInformation = "26"
+	idMerchantCategoryCode       = "52"
+	idTransactionCurrency        = "53"
+	idTransactionAmount          = "54"
+	idCountryCode                = "58"
+	idMerchantName               = "59"
+	idMerchantCity               = "60"
+	idAdditionalDataField        = "62"
+	idCRC16                      = "63"
+
+	//subcampos do Merchant Account Information
+	idGui       = "00"
+	idChave     = "01"
+	idDescricao = "02"
+
+	//subcampo do Additional Data Field
+	idTxId = "05"
+
+	gui            = "br.gov.bcb.pix"
+	moedaReal      = "986"
+	codigoPais     = "BR"
+	txIdPadrao     = "***" //txid utilizado quando não informado
+	tamanhoMaximo  = 99    //tamanho máximo do valor de um campo EMV
+	tamanhoNome    = 25
+	tamanhoCidade  = 15
+	tamanhoQrCode  = 256 //tamanho padrão em pixels da imagem do QR Code
+	tamanhoValor   = 13  //tamanho máximo do valor formatado no campo 54
+	tamanhoCrc     = 4
+	prefixoCrc     = idCRC16 + "04"
+	tamanhoPrefixo = 4 //id(2) + tamanho(2) de cada campo
+)
+
+var (
+	ErrChaveObrigatoria = errors.New("chave pix obrigatória")
+	ErrNomeObrigatorio  = errors.New("nome do recebedor obrigatório")
+	ErrValorInvalido    = errors.New("valor inválido")
+	ErrTxIdInvalido     = errors.New("txid inválido")
+	ErrCampoMuitoLongo  = errors.New("campo excede o tamanho máximo do br code")
+	ErrBrCodeInvalido   = errors.New("br code inválido")
+	ErrCrcInvalido      = errors.New("crc do br code não confere")
+	ErrChaveAusente     = errors.New("br code não possui chave pix")
+
+	reTxId = regexp.MustCompile(`^[a-zA-Z0-9]{1,25}$`)
+)
+
+// Payload contém os dados de uma cobrança Pix estática
+type Payload struct {
+	Chave         string
+	Descricao     string
+	NomeRecebedor string
+	Cidade        string
+	TxId          string
+	//valor da cobrança, quando zero o pagador informa o valor
+	Valor float64
+}
+
+// Gerar monta o BR Code (pix copia e cola) no padrão EMV-MPM com o CRC16 ao final.
+// nome, cidade e descrição são normalizados para ASCII maiúsculo e truncados no tamanho máximo,
+// os tamanhos dos campos EMV são contados em bytes
+func (p Payload) Gerar() (string, error) {
+	if p.Chave == "" {
+		return "", ErrChaveObrigatoria
+	}
+	nome := normalizarTexto(p.NomeRecebedor, tamanhoNome)
+	if nome == "" {
+		return "", ErrNomeObrigatorio
+	}
+	if p.Valor < 0 || math.IsNaN(p.Valor) || math.IsInf(p.Valor, 0) || len(formatarValor(p.Valor)) > tamanhoValor {
+		return "", ErrValorInvalido
+	}
+	txId := p.TxId
+	if txId == "" {
+		txId = txIdPadrao
+	} else if !reTxId.MatchString(txId) {
+		return "", ErrTxIdInvalido
+	}
+
+	contaRecebedor := campo(idGui, gui) + campo(idChave, p.Chave)
+	if descricao := normalizarTexto(p.Descricao, tamanhoMaximo); descricao != "" {
+		contaRecebedor += campo(idDescricao, descricao)
+	}
+	if len(contaRecebedor) > tamanhoMaximo {
+		return "", ErrCampoMuitoLongo
+	}
+
+	var sb strings.Builder
+	sb.WriteString(campo(idPayloadFormatIndicator, "01"))
+	sb.WriteString(campo(idMerchantAccountInformation, contaRecebedor))
+	sb.WriteString(campo(idMerchantCategoryCode, "0000"))
+	sb.WriteString(campo(idTransactionCurrency, moedaReal))
+	if p.Valor > 0 {
+		sb.WriteString(campo(idTransactionAmount, formatarValor(p.Valor)))
+	}
+	sb.WriteString(campo(idCountryCode, codigoPais))
+	sb.WriteString(campo(idMerchantName, nome))
+	sb.WriteString(campo(idMerchantCity, normalizarTexto(p.Cidade, tamanhoCidade)))
+	sb.WriteString(campo(idAdditionalDataField, campo(idTxId, txId)))
+	sb.WriteString(prefixoCrc)
+
+	payload := sb.String()
+	return payload + fmt.Sprintf("%04X", CRC16(payload)), nil
+}
+
+// Decodificar lê o BR Code (pix copia e cola) informado, verifica o CRC16 e
+// retorna os dados da cobrança. Apenas BR Codes estáticos (com chave) são aceitos
+func Decodificar(codigo string) (*Payload, error) {
+	codigo = strings.TrimSpace(codigo)
+	if len(codigo) < len(prefixoCrc)+tamanhoCrc {
+		return nil, ErrBrCodeInvalido
+	}
+	semCrc := codigo[:len(codigo)-tamanhoCrc]
+	if !strings.HasSuffix(semCrc, prefixoCrc) {
+		return nil, ErrBrCodeInvalido
+	}
+	crc, err := strconv.ParseUint(codigo[len(semCrc):], 16, 16)
+	if err != nil {
+		return nil, ErrBrCodeInvalido
+	}
+	if uint16(crc) != CRC16(semCrc) {
+		return nil, ErrCrcInvalido
+	}
+	campos, err := lerCampos(semCrc[:len(semCrc)-len(prefixoCrc)])
+	if err != nil {
+		return nil, err
+	}
+	if campos[idPayloadFormatIndicator] != "01" {
+		return nil, ErrBrCodeInvalido
+	}
+
+	payload := &Payload{
+		NomeRecebedor: campos[idMerchantName],
+		Cidade:        campos[idMerchantCity],
+	}
+	//o pix pode estar em qualquer template de conta entre os ids 26 e 51
+	for id := 26; id <= 51; id++ {
+		conta, ok := campos[strconv.Itoa(id)]
+		if !ok {
+			continue
+		}
+		subcampos, err := lerCampos(conta)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(subcampos[idGui], gui) {
+			continue
+		}
+		payload.Chave = subcampos[idChave]
+		payload.Descricao = subcampos[idDescricao]
+		break
+	}
+	if payload.Chave == "" {
+		return nil, ErrChaveAusente
+	}
+	if valor, ok := campos[idTransactionAmount]; ok {
+		payload.Valor, err = strconv.ParseFloat(valor, 64)
+		if err != nil {
+			return nil, ErrValorInvalido
+		}
+	}
+	if adicionais, ok := campos[idAdditionalDataField]; ok {
+		subcampos, err := lerCampos(adicionais)
+		if err != nil {
+			return nil, err
+		}
+		if txId := subcampos[idTxId]; txId != txIdPadrao {
+			payload.TxId = txId
+		}
+	}
+	return payload, nil
+}
+
+// TamanhoMaximoQRCode é o tamanho máximo em pixels da imagem do QR Code
+const TamanhoMaximoQRCode = 1024
+
+// GerarQRCode retorna a imagem PNG do QR Code do payload informado,
+// se o tamanho não for informado é utilizado o padrão de 256 pixels e
+// tamanhos acima de TamanhoMaximoQRCode são reduzidos ao máximo
+func GerarQRCode(payload string, tamanho int) ([]byte, error) {
+	if tamanho <= 0 {
+		tamanho = tamanhoQrCode
+	}
+	if tamanho > TamanhoMaximoQRCode {
+		tamanho = TamanhoMaximoQRCode
+	}
+	return qrcode.Encode(payload, qrcode.Medium, tamanho)
+}
+
+// CRC16 calcula o CRC16-CCITT (polinômio 0x1021, valor inicial 0xFFFF)
+// exigido pelo campo 63 do BR Code
+func CRC16(payload string) uint16 {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(payload); i++ {
+		crc ^= uint16(payload[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// valor com duas casas decimais, formato do campo 54
+func formatarValor(valor float64) string {
+	return strconv.FormatFloat(valor, 'f', 2, 64)
+}
+
+// monta um campo no formato id + tamanho(2 digitos) + valor
+func campo(id, valor string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(valor), valor)
+}
+
+// lê uma sequência de campos no formato id + tamanho(2 digitos) + valor
+func lerCampos(dados string) (map[string]string, error) {
+	campos := map[string]string{}
+	for len(dados) > 0 {
+		if len(dados) < tamanhoPrefixo {
+			return nil, ErrBrCodeInvalido
+		}
+		//o tamanho tem exatamente dois dígitos, Atoi aceitaria sinais como em "-1"
+		if !isDigito(dados[2]) || !isDigito(dados[3]) {
+			return nil, ErrBrCodeInvalido
+		}
+		tamanho, err := strconv.Atoi(dados[2:tamanhoPrefixo])
+		if err != nil || len(dados) < tamanhoPrefixo+tamanho {
+			return nil, ErrBrCodeInvalido
+		}
+		campos[dados[:2]] = dados[tamanhoPrefixo : tamanhoPrefixo+tamanho]
+		dados = dados[tamanhoPrefixo+tamanho:]
+	}
+	return campos, nil
+}
+
+func isDigito(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// remove acentos e caracteres especiais, converte para maiúsculo e trunca
+// o texto no tamanho informado
+func normalizarTexto(texto string, tamanho int) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	texto, _, _ = transform.String(t, texto)
+	texto = strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == ' ') {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, strings.TrimSpace(texto))
+	if len(texto) > tamanho {
+		texto = strings.TrimSpace(texto[:tamanho])
+	}
+	return texto
+}
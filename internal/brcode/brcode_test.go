@@ -0,0 +1,252 @@
+package brcode
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCRC16(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		result uint16
+	}{"valor de referência ccitt-false": {
+		input:  "123456789",
+		result: 0x29B1,
+	},
+		"exemplo do manual do bcb": {
+			input:  "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***6304",
+			result: 0x1D3D,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := CRC16(tt.input)
+			if result != tt.result {
+				t.Errorf("esperado %04X, obtido %04X", tt.result, result)
+			}
+		})
+	}
+}
+
+func TestGerar(t *testing.T) {
+	tests := map[string]struct {
+		input  Payload
+		result string
+		err    error
+	}{"sem valor e sem txid": {
+		input: Payload{
+			Chave:         "123e4567-e12b-12d1-a456-426655440000",
+			NomeRecebedor: "Fulano de Tal",
+			Cidade:        "Brasília",
+		},
+		result: "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913FULANO DE TAL6008BRASILIA62070503***6304",
+	},
+		"com valor, txid e descrição": {
+			input: Payload{
+				Chave:         "+5511987654321",
+				Descricao:     "pedido 1",
+				NomeRecebedor: "João da Silva",
+				Cidade:        "São Paulo",
+				TxId:          "PEDIDO1",
+				Valor:         10.5,
+			},
+			result: "00020126480014br.gov.bcb.pix0114+55119876543210208PEDIDO 1520400005303986540510.505802BR5913JOAO DA SILVA6009SAO PAULO62110507PEDIDO16304",
+		},
+		"descrição acentuada": {
+			input: Payload{
+				Chave:         "flavio@transfeera.com",
+				Descricao:     "Cobrança única",
+				NomeRecebedor: "Fulano",
+				Cidade:        "Aracaju",
+			},
+			result: "00020126610014br.gov.bcb.pix0121flavio@transfeera.com0214COBRANCA UNICA5204000053039865802BR5906FULANO6007ARACAJU62070503***6304",
+		},
+		"nome truncado": {
+			input: Payload{
+				Chave:         "flavio@transfeera.com",
+				NomeRecebedor: "Flávio Rodolfo de Albuquerque Neto",
+				Cidade:        "Aracaju",
+			},
+			result: "00020126430014br.gov.bcb.pix0121flavio@transfeera.com5204000053039865802BR5925FLAVIO RODOLFO DE ALBUQUE6007ARACAJU62070503***6304",
+		},
+		"sem chave": {
+			input: Payload{NomeRecebedor: "Fulano"},
+			err:   ErrChaveObrigatoria,
+		},
+		"sem nome": {
+			input: Payload{Chave: "flavio@transfeera.com"},
+			err:   ErrNomeObrigatorio,
+		},
+		"valor negativo": {
+			input: Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Fulano", Valor: -1},
+			err:   ErrValorInvalido,
+		},
+		"valor não numérico": {
+			input: Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Fulano", Valor: math.NaN()},
+			err:   ErrValorInvalido,
+		},
+		"valor infinito": {
+			input: Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Fulano", Valor: math.Inf(1)},
+			err:   ErrValorInvalido,
+		},
+		"valor com mais de 13 caracteres": {
+			input: Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Fulano", Valor: 1e10},
+			err:   ErrValorInvalido,
+		},
+		"txid com caracteres especiais": {
+			input: Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Fulano", TxId: "pedido-1"},
+			err:   ErrTxIdInvalido,
+		},
+		"descrição muito longa": {
+			input: Payload{Chave: "flavio@transfeera.com", NomeRecebedor: "Fulano", Descricao: strings.Repeat("x", 60)},
+			err:   ErrCampoMuitoLongo,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := tt.input.Gerar()
+			if err != tt.err {
+				t.Fatalf("esperado erro %v, obtido %v", tt.err, err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if !strings.HasPrefix(result, tt.result) || len(result) != len(tt.result)+4 {
+				t.Errorf("esperado %s, obtido %s", tt.result, result)
+			}
+			if crc := result[len(result)-4:]; crc != strings.ToUpper(crc) {
+				t.Errorf("crc deve estar em hexadecimal maiúsculo, obtido %s", crc)
+			}
+		})
+	}
+}
+
+func TestGerarQRCode(t *testing.T) {
+	imagem, err := GerarQRCode("00020126580014br.gov.bcb.pix", 0)
+	if err != nil {
+		t.Fatalf("erro inesperado %v", err)
+	}
+	if !strings.HasPrefix(string(imagem), "\x89PNG") {
+		t.Errorf("esperado imagem png")
+	}
+
+	grande, err := GerarQRCode("00020126580014br.gov.bcb.pix", 100000)
+	if err != nil {
+		t.Fatalf("erro inesperado %v", err)
+	}
+	config, err := png.DecodeConfig(bytes.NewReader(grande))
+	if err != nil {
+		t.Fatalf("erro inesperado %v", err)
+	}
+	if config.Width != TamanhoMaximoQRCode {
+		t.Errorf("esperado largura %d, obtido %d", TamanhoMaximoQRCode, config.Width)
+	}
+}
+
+func TestDecodificar(t *testing.T) {
+	exemploBcb := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+	tests := map[string]struct {
+		input  string
+		result *Payload
+		err    error
+	}{"exemplo do manual do bcb": {
+		input: exemploBcb,
+		result: &Payload{
+			Chave:         "123e4567-e12b-12d1-a456-426655440000",
+			NomeRecebedor: "Fulano de Tal",
+			Cidade:        "BRASILIA",
+		},
+	},
+		"crc em minúsculo": {
+			input: strings.Replace(exemploBcb, "1D3D", "1d3d", 1),
+			result: &Payload{
+				Chave:         "123e4567-e12b-12d1-a456-426655440000",
+				NomeRecebedor: "Fulano de Tal",
+				Cidade:        "BRASILIA",
+			},
+		},
+		"com valor, txid e descrição": {
+			input: gerar(t, Payload{
+				Chave:         "+5511987654321",
+				Descricao:     "pedido 1",
+				NomeRecebedor: "João da Silva",
+				Cidade:        "São Paulo",
+				TxId:          "PEDIDO1",
+				Valor:         10.5,
+			}),
+			result: &Payload{
+				Chave:         "+5511987654321",
+				Descricao:     "PEDIDO 1",
+				NomeRecebedor: "JOAO DA SILVA",
+				Cidade:        "SAO PAULO",
+				TxId:          "PEDIDO1",
+				Valor:         10.5,
+			},
+		},
+		"vazio": {
+			input: "",
+			err:   ErrBrCodeInvalido,
+		},
+		"crc alterado": {
+			input: strings.Replace(exemploBcb, "1D3D", "1D3E", 1),
+			err:   ErrCrcInvalido,
+		},
+		"payload alterado": {
+			input: strings.Replace(exemploBcb, "Fulano", "Ciclano", 1),
+			err:   ErrCrcInvalido,
+		},
+		"sem campo crc": {
+			input: exemploBcb[:len(exemploBcb)-8],
+			err:   ErrBrCodeInvalido,
+		},
+		"tamanho de campo inconsistente": {
+			input: comCrc("0002012699" + "6304"),
+			err:   ErrBrCodeInvalido,
+		},
+		"tamanho de campo negativo": {
+			input: comCrc("000201" + "26-1" + "6304"),
+			err:   ErrBrCodeInvalido,
+		},
+		"tamanho de campo com sinal": {
+			input: comCrc("000201" + "26+5" + "abcde" + "6304"),
+			err:   ErrBrCodeInvalido,
+		},
+		"sem chave pix": {
+			input: comCrc("0002015204000053039865802BR5906FULANO6008BRASILIA6304"),
+			err:   ErrChaveAusente,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := Decodificar(tt.input)
+			if err != tt.err {
+				t.Fatalf("esperado erro %v, obtido %v", tt.err, err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if *result != *tt.result {
+				t.Errorf("esperado %+v, obtido %+v", tt.result, result)
+			}
+		})
+	}
+}
+
+func gerar(t *testing.T, p Payload) string {
+	codigo, err := p.Gerar()
+	if err != nil {
+		t.Fatalf("erro inesperado %v", err)
+	}
+	return codigo
+}
+
+func comCrc(payload string) string {
+	return fmt.Sprintf("%s%04X", payload, CRC16(payload))
+}
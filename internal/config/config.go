@@ -0,0 +1,526 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	valorOculto = "********"
+
+	TamanhoPaginaMaximo = 100
+
+	RepositorioPostgres = "postgres"
+	RepositorioMemoria  = "memoria"
+	RepositorioSqlite   = "sqlite"
+
+	LimitadorNenhum   = "nenhum"
+	LimitadorMemoria  = "memoria"
+	LimitadorPostgres = "postgres"
+)
+
+// Config reúne toda a configuração da aplicação. Os valores são carregados, em ordem crescente
+// de precedência, dos valores padrão, do arquivo YAML, das variáveis de ambiente e das flags
+type Config struct {
+	Ambiente     string             `yaml:"ambiente"`
+	LogLevel     string             `yaml:"log_level"`
+	Repositorio  string             `yaml:"repositorio"`
+	Sqlite       SqliteConfig       `yaml:"sqlite"`
+	Cache        CacheConfig        `yaml:"cache"`
+	Http         HttpConfig         `yaml:"http"`
+	Grpc         GrpcConfig         `yaml:"grpc"`
+	Database     DatabaseConfig     `yaml:"database"`
+	Paginacao    PaginacaoConfig    `yaml:"paginacao"`
+	Eventos      EventosConfig      `yaml:"eventos"`
+	BrCode       BrCodeConfig       `yaml:"brcode"`
+	Saude        SaudeConfig        `yaml:"saude"`
+	Rastreamento RastreamentoConfig `yaml:"rastreamento"`
+	RateLimit    RateLimitConfig    `yaml:"rate_limit"`
+	Suspeitos    SuspeitosConfig    `yaml:"suspeitos"`
+	Triagem      TriagemConfig      `yaml:"triagem"`
+	Email        EmailConfig        `yaml:"email"`
+}
+
+// os proxies confiáveis são ips ou redes (CIDR) separados por vírgula, apenas o X-Forwarded-For
+// enviado por eles é usado para identificar o ip do cliente
+type HttpConfig struct {
+	Endereco          string        `yaml:"endereco"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout"`
+	TlsCert           string        `yaml:"tls_cert"`
+	TlsKey            string        `yaml:"tls_key"`
+	ProxiesConfiaveis string        `yaml:"proxies_confiaveis"`
+}
+
+// a api gRPC é iniciada apenas se o endereço for informado
+type GrpcConfig struct {
+	Endereco string `yaml:"endereco"`
+}
+
+type DatabaseConfig struct {
+	Host               string        `yaml:"host"`
+	Porta              int           `yaml:"porta"`
+	Usuario            string        `yaml:"usuario"`
+	Senha              string        `yaml:"senha"`
+	Nome               string        `yaml:"nome"`
+	SslMode            string        `yaml:"sslmode"`
+	MaxConexoesAbertas int           `yaml:"max_conexoes_abertas"`
+	MaxConexoesOciosas int           `yaml:"max_conexoes_ociosas"`
+	TempoVidaConexao   time.Duration `yaml:"tempo_vida_conexao"`
+	TimeoutConsulta    time.Duration `yaml:"timeout_consulta"`
+	TimeoutEscrita     time.Duration `yaml:"timeout_escrita"`
+	MigrarAoIniciar    bool          `yaml:"migrar_ao_iniciar"`
+}
+
+type SqliteConfig struct {
+	Arquivo string `yaml:"arquivo"`
+}
+
+type CacheConfig struct {
+	Capacidade int           `yaml:"capacidade"`
+	Ttl        time.Duration `yaml:"ttl"`
+}
+
+type PaginacaoConfig struct {
+	TamanhoPagina int `yaml:"tamanho_pagina"`
+}
+
+type EventosConfig struct {
+	Publicador string `yaml:"publicador"`
+	Arquivo    string `yaml:"arquivo"`
+	Url        string `yaml:"url"`
+}
+
+// dados do recebedor nos BR Codes gerados que não fazem parte do cadastro
+type BrCodeConfig struct {
+	Cidade string `yaml:"cidade"`
+}
+
+type SaudeConfig struct {
+	Timeout         time.Duration `yaml:"timeout"`
+	MaxAtrasoOutbox time.Duration `yaml:"max_atraso_outbox"`
+}
+
+type RastreamentoConfig struct {
+	Exportador string  `yaml:"exportador"`
+	Endpoint   string  `yaml:"endpoint"`
+	Amostragem float64 `yaml:"amostragem"`
+}
+
+// limite de requisições por cliente e janela em cada grupo de rotas, zero desabilita o grupo
+type RateLimitConfig struct {
+	Backend          string        `yaml:"backend"`
+	Janela           time.Duration `yaml:"janela"`
+	Leitura          int           `yaml:"leitura"`
+	Escrita          int           `yaml:"escrita"`
+	Lote             int           `yaml:"lote"`
+	CabecalhoCliente string        `yaml:"cabecalho_cliente"`
+}
+
+// detecção de recebedores suspeitos, os domínios são separados por vírgula e
+// substituem a lista padrão de domínios de email descartáveis
+type SuspeitosConfig struct {
+	Intervalo            time.Duration `yaml:"intervalo"`
+	DominiosDescartaveis string        `yaml:"dominios_descartaveis"`
+}
+
+// triagem dos recebedores nas listas restritivas, as listas são caminhos de arquivos .csv ou
+// .json separados por vírgula e a triagem é desabilitada sem listas
+type TriagemConfig struct {
+	Listas     string        `yaml:"listas"`
+	LimiarNome float64       `yaml:"limiar_nome"`
+	Intervalo  time.Duration `yaml:"intervalo"`
+}
+
+// verificação do email dos recebedores, o mailer é arquivo ou smtp e a verificação é
+// desabilitada sem mailer
+type EmailConfig struct {
+	Mailer         string        `yaml:"mailer"`
+	Diretorio      string        `yaml:"diretorio"`
+	SmtpHost       string        `yaml:"smtp_host"`
+	SmtpPorta      int           `yaml:"smtp_porta"`
+	SmtpUsuario    string        `yaml:"smtp_usuario"`
+	SmtpSenha      string        `yaml:"smtp_senha"`
+	Remetente      string        `yaml:"remetente"`
+	Segredo        string        `yaml:"segredo"`
+	ValidadeToken  time.Duration `yaml:"validade_token"`
+	UrlVerificacao string        `yaml:"url_verificacao"`
+}
+
+// Opcoes são as opções de linha de comando que não fazem parte da configuração
+type Opcoes struct {
+	ImprimirConfig bool
+	//argumentos após as flags, ex: migrate up
+	Argumentos []string
+}
+
+// valores padrão, os campos obrigatórios não possuem valor padrão
+func padrao() *Config {
+	return &Config{
+		Ambiente:    "PROD",
+		Repositorio: RepositorioPostgres,
+		Sqlite:      SqliteConfig{Arquivo: "transfeera.db"},
+		Cache:       CacheConfig{Capacidade: 10000, Ttl: time.Minute},
+		LogLevel:    "info",
+		Http: HttpConfig{
+			Endereco:        ":8080",
+			ReadTimeout:     15 * time.Second,
+			WriteTimeout:    15 * time.Second,
+			IdleTimeout:     60 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Porta:              5432,
+			SslMode:            "disable",
+			MaxConexoesAbertas: 25,
+			MaxConexoesOciosas: 25,
+			TempoVidaConexao:   5 * time.Minute,
+			TimeoutConsulta:    5 * time.Second,
+			TimeoutEscrita:     10 * time.Second,
+		},
+		Paginacao: PaginacaoConfig{TamanhoPagina: 10},
+		BrCode:    BrCodeConfig{Cidade: "SAO PAULO"},
+		Saude: SaudeConfig{
+			Timeout:         2 * time.Second,
+			MaxAtrasoOutbox: time.Minute,
+		},
+		Rastreamento: RastreamentoConfig{Amostragem: 1},
+		RateLimit: RateLimitConfig{
+			Backend: LimitadorMemoria,
+			Janela:  time.Minute,
+			Leitura: 600,
+			Escrita: 120,
+			Lote:    10,
+		},
+		Suspeitos: SuspeitosConfig{Intervalo: time.Hour},
+		Triagem:   TriagemConfig{LimiarNome: 0.9, Intervalo: 24 * time.Hour},
+		Email: EmailConfig{
+			SmtpPorta:      587,
+			Remetente:      "nao-responda@transfeera.com",
+			ValidadeToken:  24 * time.Hour,
+			UrlVerificacao: "http://localhost:8080/api/v1/recebedores/verificar-email",
+		},
+	}
+}
+
+// campo configurável por variável de ambiente e flag
+type campo struct {
+	env       string
+	flag      string
+	descricao string
+	destino   interface{}
+}
+
+func (c *Config) campos() []campo {
+	return []campo{
+		{"ENV", "ambiente", "ambiente de execução (DEV ou PROD)", &c.Ambiente},
+		{"REPOSITORIO", "repositorio", "armazenamento dos recebedores (postgres, sqlite ou memoria)", &c.Repositorio},
+		{"SQLITE_ARQUIVO", "sqlite-arquivo", "arquivo do banco de dados SQLite", &c.Sqlite.Arquivo},
+		{"CACHE_CAPACIDADE", "cache-capacidade", "recebedores e chaves mantidos no cache, 0 desabilita o cache", &c.Cache.Capacidade},
+		{"CACHE_TTL", "cache-ttl", "tempo de vida dos valores no cache", &c.Cache.Ttl},
+		{"LOG_LEVEL", "log-level", "nível de log (debug, info, warn ou error)", &c.LogLevel},
+		{"HTTP_ADDR", "http-addr", "endereço de escuta do servidor", &c.Http.Endereco},
+		{"GRPC_ADDR", "grpc-addr", "endereço de escuta do servidor gRPC, vazio desabilita a api gRPC", &c.Grpc.Endereco},
+		{"HTTP_READ_TIMEOUT", "http-read-timeout", "timeout de leitura das requisições", &c.Http.ReadTimeout},
+		{"HTTP_WRITE_TIMEOUT", "http-write-timeout", "timeout de escrita das respostas", &c.Http.WriteTimeout},
+		{"HTTP_IDLE_TIMEOUT", "http-idle-timeout", "timeout das conexões ociosas", &c.Http.IdleTimeout},
+		{"HTTP_SHUTDOWN_TIMEOUT", "http-shutdown-timeout", "espera pelas requisições em andamento no encerramento", &c.Http.ShutdownTimeout},
+		{"TLS_CERT_FILE", "tls-cert", "certificado TLS", &c.Http.TlsCert},
+		{"TLS_KEY_FILE", "tls-key", "chave privada do certificado TLS", &c.Http.TlsKey},
+		{"HTTP_TRUSTED_PROXIES", "http-trusted-proxies", "ips ou redes dos proxies confiáveis separados por vírgula, vazio usa o ip da conexão", &c.Http.ProxiesConfiaveis},
+		{"DATABASE_HOST", "db-host", "host do banco de dados", &c.Database.Host},
+		{"DATABASE_PORT", "db-port", "porta do banco de dados", &c.Database.Porta},
+		{"DATABASE_USER", "db-user", "usuário do banco de dados", &c.Database.Usuario},
+		{"DATABASE_PASS", "db-pass", "senha do banco de dados", &c.Database.Senha},
+		{"DATABASE_NAME", "db-name", "nome do banco de dados", &c.Database.Nome},
+		{"DATABASE_SSLMODE", "db-sslmode", "sslmode da conexão com o banco de dados", &c.Database.SslMode},
+		{"DATABASE_MAX_OPEN_CONNS", "db-max-open-conns", "máximo de conexões abertas", &c.Database.MaxConexoesAbertas},
+		{"DATABASE_MAX_IDLE_CONNS", "db-max-idle-conns", "máximo de conexões ociosas", &c.Database.MaxConexoesOciosas},
+		{"DATABASE_CONN_MAX_LIFETIME", "db-conn-max-lifetime", "tempo de vida máximo de uma conexão", &c.Database.TempoVidaConexao},
+		{"DATABASE_QUERY_TIMEOUT", "db-query-timeout", "tempo máximo de cada consulta", &c.Database.TimeoutConsulta},
+		{"DATABASE_WRITE_TIMEOUT", "db-write-timeout", "tempo máximo de cada operação de escrita", &c.Database.TimeoutEscrita},
+		{"DATABASE_AUTO_MIGRATE", "db-auto-migrate", "aplica as migrações pendentes ao iniciar (true ou false)", &c.Database.MigrarAoIniciar},
+		{"TAMANHO_PAGINA", "tamanho-pagina", "quantidade de recebedores por página", &c.Paginacao.TamanhoPagina},
+		{"PUBLICADOR_EVENTOS", "publicador-eventos", "destino dos eventos (stdout, arquivo ou http)", &c.Eventos.Publicador},
+		{"PUBLICADOR_ARQUIVO", "publicador-arquivo", "arquivo do publicador de eventos", &c.Eventos.Arquivo},
+		{"PUBLICADOR_URL", "publicador-url", "url do publicador de eventos", &c.Eventos.Url},
+		{"BRCODE_CIDADE", "brcode-cidade", "cidade do recebedor informada nos BR Codes gerados", &c.BrCode.Cidade},
+		{"SAUDE_TIMEOUT", "saude-timeout", "timeout das verificações de prontidão", &c.Saude.Timeout},
+		{"RASTREAMENTO_EXPORTADOR", "rastreamento-exportador", "exportador dos traces (otlp ou stdout)", &c.Rastreamento.Exportador},
+		{"RASTREAMENTO_OTLP_ENDPOINT", "rastreamento-otlp-endpoint", "url do coletor OTLP/HTTP dos traces", &c.Rastreamento.Endpoint},
+		{"RASTREAMENTO_AMOSTRAGEM", "rastreamento-amostragem", "fração dos traces registrados, entre 0 e 1", &c.Rastreamento.Amostragem},
+		{"SAUDE_MAX_ATRASO_OUTBOX", "saude-max-atraso-outbox", "atraso máximo do outbox para a aplicação estar pronta", &c.Saude.MaxAtrasoOutbox},
+		{"RATE_LIMIT_BACKEND", "rate-limit-backend", "armazenamento do limite de requisições (nenhum, memoria ou postgres)", &c.RateLimit.Backend},
+		{"RATE_LIMIT_JANELA", "rate-limit-janela", "janela do limite de requisições", &c.RateLimit.Janela},
+		{"RATE_LIMIT_LEITURA", "rate-limit-leitura", "requisições de leitura por cliente na janela, 0 desabilita", &c.RateLimit.Leitura},
+		{"RATE_LIMIT_ESCRITA", "rate-limit-escrita", "requisições de escrita por cliente na janela, 0 desabilita", &c.RateLimit.Escrita},
+		{"RATE_LIMIT_LOTE", "rate-limit-lote", "requisições em lote por cliente na janela, 0 desabilita", &c.RateLimit.Lote},
+		{"RATE_LIMIT_CABECALHO_CLIENTE", "rate-limit-cabecalho-cliente", "cabeçalho que identifica o cliente, definido pelo gateway autenticado, na ausência é usado o ip", &c.RateLimit.CabecalhoCliente},
+		{"SUSPEITOS_INTERVALO", "suspeitos-intervalo", "intervalo entre as detecções de recebedores suspeitos", &c.Suspeitos.Intervalo},
+		{"SUSPEITOS_DOMINIOS_DESCARTAVEIS", "suspeitos-dominios-descartaveis", "domínios de email descartáveis separados por vírgula, vazio usa a lista padrão", &c.Suspeitos.DominiosDescartaveis},
+		{"LISTAS_RESTRITIVAS", "listas-restritivas", "arquivos .csv ou .json das listas restritivas separados por vírgula, vazio desabilita a triagem", &c.Triagem.Listas},
+		{"TRIAGEM_LIMIAR_NOME", "triagem-limiar-nome", "similaridade mínima entre os nomes, de 0 a 1, para a correspondência na lista restritiva", &c.Triagem.LimiarNome},
+		{"TRIAGEM_INTERVALO", "triagem-intervalo", "intervalo entre as triagens dos recebedores existentes", &c.Triagem.Intervalo},
+		{"EMAIL_MAILER", "email-mailer", "envio dos emails de verificação (arquivo ou smtp), vazio desabilita a verificação", &c.Email.Mailer},
+		{"EMAIL_DIRETORIO", "email-diretorio", "diretório dos emails gravados pelo mailer arquivo", &c.Email.Diretorio},
+		{"SMTP_HOST", "smtp-host", "host do servidor SMTP", &c.Email.SmtpHost},
+		{"SMTP_PORTA", "smtp-porta", "porta do servidor SMTP", &c.Email.SmtpPorta},
+		{"SMTP_USUARIO", "smtp-usuario", "usuário do servidor SMTP, vazio desabilita a autenticação", &c.Email.SmtpUsuario},
+		{"SMTP_SENHA", "smtp-senha", "senha do servidor SMTP", &c.Email.SmtpSenha},
+		{"EMAIL_REMETENTE", "email-remetente", "remetente dos emails de verificação", &c.Email.Remetente},
+		{"EMAIL_VERIFICACAO_SEGREDO", "email-verificacao-segredo", "segredo da assinatura dos tokens, vazio gera um segredo a cada execução", &c.Email.Segredo},
+		{"EMAIL_VERIFICACAO_VALIDADE", "email-verificacao-validade", "validade do token de verificação do email", &c.Email.ValidadeToken},
+		{"EMAIL_VERIFICACAO_URL", "email-verificacao-url", "url do link de verificação enviado ao recebedor", &c.Email.UrlVerificacao},
+	}
+}
+
+// atribui o valor textual ao campo de acordo com o seu tipo
+func (c campo) atribuir(valor string) error {
+	switch destino := c.destino.(type) {
+	case *string:
+		*destino = valor
+	case *int:
+		n, err := strconv.Atoi(valor)
+		if err != nil {
+			return fmt.Errorf("%s deve ser um número inteiro: %q", c.env, valor)
+		}
+		*destino = n
+	case *float64:
+		f, err := strconv.ParseFloat(valor, 64)
+		if err != nil {
+			return fmt.Errorf("%s deve ser um número: %q", c.env, valor)
+		}
+		*destino = f
+	case *bool:
+		b, err := strconv.ParseBool(valor)
+		if err != nil {
+			return fmt.Errorf("%s deve ser true ou false: %q", c.env, valor)
+		}
+		*destino = b
+	case *time.Duration:
+		d, err := time.ParseDuration(valor)
+		if err != nil {
+			return fmt.Errorf("%s deve ser uma duração (ex: 15s, 1m): %q", c.env, valor)
+		}
+		*destino = d
+	}
+	return nil
+}
+
+// Carregar lê a configuração a partir dos argumentos de linha de comando (sem o nome do programa)
+// e das variáveis de ambiente, retornando erro se algum valor for inválido ou obrigatório estiver ausente
+func Carregar(args []string) (*Config, *Opcoes, error) {
+	return carregar(args, os.LookupEnv)
+}
+
+func carregar(args []string, lookupEnv func(string) (string, bool)) (*Config, *Opcoes, error) {
+	config := padrao()
+	opcoes := &Opcoes{}
+	campos := config.campos()
+
+	fs := flag.NewFlagSet("api", flag.ContinueOnError)
+	arquivo := fs.String("config", "", "arquivo de configuração YAML (ou CONFIG_FILE)")
+	fs.BoolVar(&opcoes.ImprimirConfig, "print-config", false, "imprime a configuração efetiva e encerra")
+	//as flags são lidas como texto e aplicadas após o arquivo e as variáveis de ambiente
+	for _, c := range campos {
+		fs.String(c.flag, "", fmt.Sprintf("%s (%s)", c.descricao, c.env))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	opcoes.Argumentos = fs.Args()
+
+	if *arquivo == "" {
+		*arquivo, _ = lookupEnv("CONFIG_FILE")
+	}
+	if *arquivo != "" {
+		conteudo, err := os.ReadFile(*arquivo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("lendo arquivo de configuração: %w", err)
+		}
+		if err := yaml.Unmarshal(conteudo, config); err != nil {
+			return nil, nil, fmt.Errorf("arquivo de configuração %s inválido: %w", *arquivo, err)
+		}
+	}
+
+	for _, c := range campos {
+		if valor, ok := lookupEnv(c.env); ok && valor != "" {
+			if err := c.atribuir(valor); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	flags := map[string]string{}
+	fs.Visit(func(f *flag.Flag) { flags[f.Name] = f.Value.String() })
+	for _, c := range campos {
+		if valor, ok := flags[c.flag]; ok {
+			if err := c.atribuir(valor); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if err := config.Validar(); err != nil {
+		return nil, nil, err
+	}
+	return config, opcoes, nil
+}
+
+// Validar retorna todos os problemas encontrados na configuração
+func (c *Config) Validar() error {
+	var erros []error
+	obrigatorio := func(valor, nome string) {
+		if valor == "" {
+			erros = append(erros, fmt.Errorf("%s é obrigatório", nome))
+		}
+	}
+	//o repositório em memória não usa o banco de dados
+	if c.Repositorio == RepositorioPostgres {
+		obrigatorio(c.Database.Host, "DATABASE_HOST")
+		obrigatorio(c.Database.Usuario, "DATABASE_USER")
+		obrigatorio(c.Database.Nome, "DATABASE_NAME")
+	}
+	if c.Cache.Capacidade < 0 || c.Cache.Ttl <= 0 {
+		erros = append(erros, errors.New("CACHE_CAPACIDADE não pode ser negativa e CACHE_TTL deve ser positivo"))
+	}
+	if c.Repositorio == RepositorioSqlite {
+		obrigatorio(c.Sqlite.Arquivo, "SQLITE_ARQUIVO")
+	}
+	if !contem([]string{RepositorioPostgres, RepositorioSqlite, RepositorioMemoria}, c.Repositorio) {
+		erros = append(erros, fmt.Errorf("REPOSITORIO inválido: %q", c.Repositorio))
+	}
+
+	if c.Database.Porta < 1 || c.Database.Porta > 65535 {
+		erros = append(erros, fmt.Errorf("DATABASE_PORT inválida: %d", c.Database.Porta))
+	}
+	if !contem([]string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}, c.Database.SslMode) {
+		erros = append(erros, fmt.Errorf("DATABASE_SSLMODE inválido: %q", c.Database.SslMode))
+	}
+	if c.Database.MaxConexoesAbertas < 0 || c.Database.MaxConexoesOciosas < 0 || c.Database.TempoVidaConexao < 0 {
+		erros = append(erros, errors.New("os limites do pool de conexões não podem ser negativos"))
+	}
+	if c.Database.TimeoutConsulta <= 0 || c.Database.TimeoutEscrita <= 0 {
+		erros = append(erros, errors.New("DATABASE_QUERY_TIMEOUT e DATABASE_WRITE_TIMEOUT devem ser positivos"))
+	}
+	if !contem([]string{"debug", "info", "warn", "error"}, c.LogLevel) {
+		erros = append(erros, fmt.Errorf("LOG_LEVEL inválido: %q", c.LogLevel))
+	}
+	if c.Http.ReadTimeout <= 0 || c.Http.WriteTimeout <= 0 || c.Http.IdleTimeout <= 0 || c.Http.ShutdownTimeout <= 0 {
+		erros = append(erros, errors.New("os timeouts do servidor http devem ser positivos"))
+	}
+	if c.Saude.Timeout <= 0 || c.Saude.MaxAtrasoOutbox <= 0 {
+		erros = append(erros, errors.New("SAUDE_TIMEOUT e SAUDE_MAX_ATRASO_OUTBOX devem ser positivos"))
+	}
+	if (c.Http.TlsCert == "") != (c.Http.TlsKey == "") {
+		erros = append(erros, errors.New("TLS_CERT_FILE e TLS_KEY_FILE devem ser informados juntos"))
+	}
+	if c.Paginacao.TamanhoPagina < 1 || c.Paginacao.TamanhoPagina > TamanhoPaginaMaximo {
+		erros = append(erros, fmt.Errorf("TAMANHO_PAGINA deve estar entre 1 e %d", TamanhoPaginaMaximo))
+	}
+	obrigatorio(strings.TrimSpace(c.BrCode.Cidade), "BRCODE_CIDADE")
+	if !contem([]string{"", "otlp", "stdout"}, c.Rastreamento.Exportador) {
+		erros = append(erros, fmt.Errorf("RASTREAMENTO_EXPORTADOR desconhecido: %q", c.Rastreamento.Exportador))
+	}
+	if !contem([]string{LimitadorNenhum, LimitadorMemoria, LimitadorPostgres}, c.RateLimit.Backend) {
+		erros = append(erros, fmt.Errorf("RATE_LIMIT_BACKEND inválido: %q", c.RateLimit.Backend))
+	}
+	if c.RateLimit.Backend == LimitadorPostgres && c.Repositorio != RepositorioPostgres {
+		erros = append(erros, errors.New("RATE_LIMIT_BACKEND postgres exige REPOSITORIO postgres"))
+	}
+	if c.RateLimit.Janela <= 0 || c.RateLimit.Leitura < 0 || c.RateLimit.Escrita < 0 || c.RateLimit.Lote < 0 {
+		erros = append(erros, errors.New("RATE_LIMIT_JANELA deve ser positiva e os limites não podem ser negativos"))
+	}
+	if c.Http.ProxiesConfiaveis != "" {
+		for _, proxy := range strings.Split(c.Http.ProxiesConfiaveis, ",") {
+			proxy = strings.TrimSpace(proxy)
+			if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+				erros = append(erros, fmt.Errorf("HTTP_TRUSTED_PROXIES inválido: %q", proxy))
+			}
+		}
+	}
+	//o cabeçalho é enviado pelo próprio cliente, só identifica o cliente se for definido por um gateway autenticado
+	if c.RateLimit.CabecalhoCliente != "" && c.Http.ProxiesConfiaveis == "" {
+		erros = append(erros, errors.New("RATE_LIMIT_CABECALHO_CLIENTE exige HTTP_TRUSTED_PROXIES com o gateway que autentica os clientes"))
+	}
+	if c.Suspeitos.Intervalo <= 0 {
+		erros = append(erros, errors.New("SUSPEITOS_INTERVALO deve ser positivo"))
+	}
+	if c.Triagem.LimiarNome <= 0 || c.Triagem.LimiarNome > 1 {
+		erros = append(erros, errors.New("TRIAGEM_LIMIAR_NOME deve ser maior que 0 e no máximo 1"))
+	}
+	if c.Triagem.Intervalo <= 0 {
+		erros = append(erros, errors.New("TRIAGEM_INTERVALO deve ser positivo"))
+	}
+	if c.Rastreamento.Amostragem < 0 || c.Rastreamento.Amostragem > 1 {
+		erros = append(erros, errors.New("RASTREAMENTO_AMOSTRAGEM deve estar entre 0 e 1"))
+	}
+	switch c.Eventos.Publicador {
+	case "", "stdout":
+	case "arquivo":
+		obrigatorio(c.Eventos.Arquivo, "PUBLICADOR_ARQUIVO")
+	case "http":
+		obrigatorio(c.Eventos.Url, "PUBLICADOR_URL")
+	default:
+		erros = append(erros, fmt.Errorf("PUBLICADOR_EVENTOS desconhecido: %q", c.Eventos.Publicador))
+	}
+	switch c.Email.Mailer {
+	case "":
+	case "arquivo":
+		obrigatorio(c.Email.Diretorio, "EMAIL_DIRETORIO")
+	case "smtp":
+		obrigatorio(c.Email.SmtpHost, "SMTP_HOST")
+		obrigatorio(c.Email.Remetente, "EMAIL_REMETENTE")
+		if c.Email.SmtpPorta <= 0 || c.Email.SmtpPorta > 65535 {
+			erros = append(erros, errors.New("SMTP_PORTA deve estar entre 1 e 65535"))
+		}
+	default:
+		erros = append(erros, fmt.Errorf("EMAIL_MAILER desconhecido: %q", c.Email.Mailer))
+	}
+	if c.Email.ValidadeToken <= 0 {
+		erros = append(erros, errors.New("EMAIL_VERIFICACAO_VALIDADE deve ser positiva"))
+	}
+	return errors.Join(erros...)
+}
+
+// Imprimir escreve a configuração efetiva em YAML, com os segredos ocultos
+func (c *Config) Imprimir(w io.Writer) error {
+	copia := *c
+	if copia.Database.Senha != "" {
+		copia.Database.Senha = valorOculto
+	}
+	if copia.Email.SmtpSenha != "" {
+		copia.Email.SmtpSenha = valorOculto
+	}
+	if copia.Email.Segredo != "" {
+		copia.Email.Segredo = valorOculto
+	}
+	encoder := yaml.NewEncoder(w)
+	defer encoder.Close()
+	return encoder.Encode(copia)
+}
+
+// DatabaseUri retorna a string de conexão com o postgres
+func (c DatabaseConfig) DatabaseUri() string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		c.Host, c.Porta, c.Usuario, c.Senha, c.Nome, c.SslMode)
+}
+
+func contem(valores []string, valor string) bool {
+	for _, v := range valores {
+		if v == valor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ambiente mínimo válido
+func envTeste(valores map[string]string) func(string) (string, bool) {
+	env := map[string]string{
+		"DATABASE_HOST": "localhost",
+		"DATABASE_USER": "postgres",
+		"DATABASE_NAME": "transfeera_db",
+	}
+	for k, v := range valores {
+		env[k] = v
+	}
+	return func(nome string) (string, bool) {
+		valor, ok := env[nome]
+		return valor, ok
+	}
+}
+
+func TestCarregar_Padrao(t *testing.T) {
+	config, opcoes, err := carregar(nil, envTeste(nil))
+	assert.NoError(t, err)
+	assert.False(t, opcoes.ImprimirConfig)
+	assert.Equal(t, ":8080", config.Http.Endereco)
+	assert.Equal(t, "disable", config.Database.SslMode)
+	assert.Equal(t, 10, config.Paginacao.TamanhoPagina)
+	assert.Equal(t, "host=localhost port=5432 user=postgres password= dbname=transfeera_db sslmode=disable", config.Database.DatabaseUri())
+}
+
+func TestCarregar_Precedencia(t *testing.T) {
+	arquivo := filepath.Join(t.TempDir(), "config.yaml")
+	yaml := "log_level: debug\nhttp:\n  endereco: ':9000'\n  read_timeout: 5s\ndatabase:\n  sslmode: require\n"
+	assert.NoError(t, os.WriteFile(arquivo, []byte(yaml), 0o600))
+
+	env := envTeste(map[string]string{"HTTP_ADDR": ":9100", "DATABASE_SSLMODE": "verify-full"})
+	config, _, err := carregar([]string{"--config", arquivo, "--http-addr", ":9200"}, env)
+	assert.NoError(t, err)
+	//arquivo < variável de ambiente < flag
+	assert.Equal(t, "debug", config.LogLevel)
+	assert.Equal(t, 5*time.Second, config.Http.ReadTimeout)
+	assert.Equal(t, "verify-full", config.Database.SslMode)
+	assert.Equal(t, ":9200", config.Http.Endereco)
+}
+
+func TestCarregar_Argumentos(t *testing.T) {
+	config, opcoes, err := carregar([]string{"--db-auto-migrate", "true", "migrate", "status"}, envTeste(nil))
+	assert.NoError(t, err)
+	assert.True(t, config.Database.MigrarAoIniciar)
+	assert.Equal(t, []string{"migrate", "status"}, opcoes.Argumentos)
+}
+
+func TestCarregar_ObrigatoriosAusentes(t *testing.T) {
+	_, _, err := carregar(nil, func(string) (string, bool) { return "", false })
+	assert.ErrorContains(t, err, "DATABASE_HOST é obrigatório")
+	assert.ErrorContains(t, err, "DATABASE_USER é obrigatório")
+	assert.ErrorContains(t, err, "DATABASE_NAME é obrigatório")
+}
+
+func TestCarregar_RepositorioMemoria(t *testing.T) {
+	config, _, err := carregar(nil, func(nome string) (string, bool) {
+		return map[string]string{"REPOSITORIO": "memoria"}[nome], nome == "REPOSITORIO"
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, RepositorioMemoria, config.Repositorio)
+}
+
+func TestCarregar_ValoresInvalidos(t *testing.T) {
+	casos := []struct {
+		env  map[string]string
+		erro string
+	}{
+		{map[string]string{"DATABASE_PORT": "abc"}, "DATABASE_PORT deve ser um número inteiro"},
+		{map[string]string{"HTTP_READ_TIMEOUT": "15"}, "HTTP_READ_TIMEOUT deve ser uma duração"},
+		{map[string]string{"DATABASE_SSLMODE": "talvez"}, "DATABASE_SSLMODE inválido"},
+		{map[string]string{"LOG_LEVEL": "verbose"}, "LOG_LEVEL inválido"},
+		{map[string]string{"TAMANHO_PAGINA": "500"}, "TAMANHO_PAGINA deve estar entre 1 e 100"},
+		{map[string]string{"TLS_CERT_FILE": "cert.pem"}, "TLS_CERT_FILE e TLS_KEY_FILE devem ser informados juntos"},
+		{map[string]string{"PUBLICADOR_EVENTOS": "http"}, "PUBLICADOR_URL é obrigatório"},
+		{map[string]string{"BRCODE_CIDADE": " "}, "BRCODE_CIDADE é obrigatório"},
+		{map[string]string{"DATABASE_QUERY_TIMEOUT": "0s"}, "DATABASE_QUERY_TIMEOUT e DATABASE_WRITE_TIMEOUT devem ser positivos"},
+		{map[string]string{"DATABASE_AUTO_MIGRATE": "sim"}, "DATABASE_AUTO_MIGRATE deve ser true ou false"},
+		{map[string]string{"REPOSITORIO": "redis"}, "REPOSITORIO inválido"},
+		{map[string]string{"CACHE_TTL": "0s"}, "CACHE_TTL deve ser positivo"},
+		{map[string]string{"RASTREAMENTO_AMOSTRAGEM": "1.5"}, "RASTREAMENTO_AMOSTRAGEM deve estar entre 0 e 1"},
+		{map[string]string{"RASTREAMENTO_EXPORTADOR": "zipkin"}, "RASTREAMENTO_EXPORTADOR desconhecido"},
+		{map[string]string{"RATE_LIMIT_BACKEND": "redis"}, "RATE_LIMIT_BACKEND inválido"},
+		{map[string]string{"RATE_LIMIT_BACKEND": "postgres", "REPOSITORIO": "memoria"}, "RATE_LIMIT_BACKEND postgres exige REPOSITORIO postgres"},
+		{map[string]string{"RATE_LIMIT_LEITURA": "-1"}, "os limites não podem ser negativos"},
+		{map[string]string{"HTTP_TRUSTED_PROXIES": "10.0.0.1, proxy.local"}, `HTTP_TRUSTED_PROXIES inválido: "proxy.local"`},
+		{map[string]string{"RATE_LIMIT_CABECALHO_CLIENTE": "X-Client-Id"}, "RATE_LIMIT_CABECALHO_CLIENTE exige HTTP_TRUSTED_PROXIES"},
+		{map[string]string{"SUSPEITOS_INTERVALO": "0s"}, "SUSPEITOS_INTERVALO deve ser positivo"},
+		{map[string]string{"TRIAGEM_LIMIAR_NOME": "1.5"}, "TRIAGEM_LIMIAR_NOME deve ser maior que 0 e no máximo 1"},
+		{map[string]string{"TRIAGEM_INTERVALO": "-1h"}, "TRIAGEM_INTERVALO deve ser positivo"},
+		{map[string]string{"EMAIL_MAILER": "arquivo"}, "EMAIL_DIRETORIO é obrigatório"},
+		{map[string]string{"EMAIL_MAILER": "smtp"}, "SMTP_HOST é obrigatório"},
+		{map[string]string{"EMAIL_MAILER": "smtp", "SMTP_HOST": "localhost", "SMTP_PORTA": "0"}, "SMTP_PORTA deve estar entre 1 e 65535"},
+		{map[string]string{"EMAIL_MAILER": "sendgrid"}, "EMAIL_MAILER desconhecido"},
+		{map[string]string{"EMAIL_VERIFICACAO_VALIDADE": "0s"}, "EMAIL_VERIFICACAO_VALIDADE deve ser positiva"},
+	}
+	for _, caso := range casos {
+		t.Run(caso.erro, func(t *testing.T) {
+			_, _, err := carregar(nil, envTeste(caso.env))
+			assert.ErrorContains(t, err, caso.erro)
+		})
+	}
+}
+
+func TestImprimir_OcultaSenha(t *testing.T) {
+	config, opcoes, err := carregar([]string{"--print-config"}, envTeste(map[string]string{
+		"DATABASE_PASS": "postgres_pwd", "SMTP_SENHA": "smtp_pwd", "EMAIL_VERIFICACAO_SEGREDO": "segredo_tokens",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, opcoes.ImprimirConfig)
+
+	var buf bytes.Buffer
+	assert.NoError(t, config.Imprimir(&buf))
+	assert.NotContains(t, buf.String(), "postgres_pwd")
+	assert.NotContains(t, buf.String(), "smtp_pwd")
+	assert.NotContains(t, buf.String(), "segredo_tokens")
+	assert.Contains(t, buf.String(), valorOculto)
+	assert.Contains(t, buf.String(), "read_timeout: 15s")
+	//a configuração original não é alterada
+	assert.Equal(t, "postgres_pwd", config.Database.Senha)
+}
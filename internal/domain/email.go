@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// email em texto simples enviado pela aplicação
+type MensagemEmail struct {
+	Para    string
+	Assunto string
+	Corpo   string
+}
+
+// Mailer envia os emails da aplicação, como o link de verificação do email do recebedor
+type Mailer interface {
+	Enviar(ctx context.Context, mensagem MensagemEmail) error
+}
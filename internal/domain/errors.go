@@ -25,4 +25,16 @@ var (
 	ErrRecebedorNaoEncontrado    = errors.New("recebedor não existe")
 	ErrRecebedorNaoPermiteEdicao = errors.New("recebedor com status Validado apenas permite edição de email")
 	ErrChavePixJaCadastrada      = errors.New("chave pix já cadastrada")
+	ErrUrlWebhookInvalida        = errors.New("url do webhook inválida")
+	ErrEventoInvalido            = errors.New("tipo de evento inválido")
+	ErrWebhookNaoEncontrado      = errors.New("webhook não existe")
+	ErrEntregaNaoEncontrada      = errors.New("entrega de webhook não existe")
+	ErrRecebedorBloqueado        = errors.New("recebedor bloqueado pela triagem de listas restritivas")
+	ErrOcorrenciaNaoEncontrada   = errors.New("ocorrência de triagem não existe")
+	ErrOcorrenciaJaRevisada      = errors.New("ocorrência de triagem já revisada")
+	ErrDecisaoInvalida           = errors.New("decisão inválida, informe Confirmada ou Liberada")
+	ErrStatusOcorrenciaInvalido  = errors.New("status de ocorrência inválido, informe Pendente, Confirmada ou Liberada")
+	ErrTokenVerificacaoInvalido  = errors.New("token de verificação de email inválido")
+	ErrTokenVerificacaoExpirado  = errors.New("token de verificação de email expirado")
+	ErrEmailJaVerificado         = errors.New("email do recebedor já verificado")
 )
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type TipoEvento string
+
+const (
+	EventoRecebedorCriado    TipoEvento = "recebedor.criado"
+	EventoRecebedorEditado   TipoEvento = "recebedor.editado"
+	EventoRecebedorValidado  TipoEvento = "recebedor.validado"
+	EventoRecebedorDeletado  TipoEvento = "recebedor.deletado"
+	EventoRecebedorBloqueado TipoEvento = "recebedor.bloqueado"
+)
+
+// tipos de eventos emitidos durante o ciclo de vida de um recebedor
+var TiposEvento = []TipoEvento{EventoRecebedorCriado, EventoRecebedorEditado, EventoRecebedorValidado, EventoRecebedorDeletado, EventoRecebedorBloqueado}
+
+type Evento struct {
+	Id         string     `json:"id"`
+	Tipo       TipoEvento `json:"tipo"`
+	OcorridoEm time.Time  `json:"ocorrido_em"`
+	Recebedor  *Recebedor `json:"recebedor"`
+}
+
+// publica os eventos de domínio para os interessados (webhooks, filas, logs...)
+type PublicadorEventos interface {
+	Publicar(ctx context.Context, evento Evento) error
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type TipoChavePix string
 
 const (
@@ -10,12 +12,28 @@ const (
 	ChaveAleatoria TipoChavePix = "CHAVE_ALEATORIA"
 )
 
+const (
+	StatusRascunho = "Rascunho"
+	StatusValidado = "Validado"
+	//recebedor encontrado em uma lista restritiva, aguardando a revisão das ocorrências
+	StatusBloqueado = "Bloqueado"
+)
+
 type PaginaRecebedores struct {
 	Total        int          `json:"total"`
 	PorPagina    int          `json:"por_pagina"`
 	PaginaAtual  int          `json:"pagina_atual"`
 	TotalPaginas int          `json:"total_paginas"`
 	Recebedores  []*Recebedor `json:"recebedores"`
+	//tipos de chave consultados na busca por chave
+	TiposChave []TipoChavePix `json:"tipos_chave,omitempty"`
+}
+
+// quantidade de recebedores com o mesmo status e tipo de chave
+type ContagemRecebedores struct {
+	Status       string
+	TipoChavePix string
+	Total        int
 }
 
 type Recebedor struct {
@@ -24,6 +42,12 @@ type Recebedor struct {
 	Nome         string       `json:"nome" validate:"required"`
 	TipoChavePix TipoChavePix `json:"tipo_chave_pix" validate:"required"`
 	ChavePix     string       `json:"chave_pix" validate:"required"`
-	Status       string       `json:"status"`
-	Email        string       `json:"email"`
+	//chave pix formatada para exibição, preenchida apenas para chaves do tipo telefone
+	ChavePixFormatada string `json:"chave_pix_formatada,omitempty"`
+	//tipo de chave em que o recebedor foi encontrado na busca por chave
+	TipoCorrespondente TipoChavePix `json:"tipo_correspondente,omitempty"`
+	Status             string       `json:"status"`
+	Email              string       `json:"email"`
+	//data da confirmação do email pelo token de verificação, vazio se o email não foi verificado
+	EmailVerificadoEm *time.Time `json:"email_verificado_em,omitempty"`
 }
@@ -1,13 +1,25 @@
 package domain
 
+import (
+	"context"
+	"time"
+)
+
 type RecebedorRepository interface {
-	BuscarRecebedorPorId(id uint) (*Recebedor, error)
-	BuscarRecebedoresPorCampo(valor, nomeCampo string, offset int) ([]*Recebedor, error)
-	ContarRecebedoresPorCampo(valor, nomeCampo string) (int, error)
-	CriarRecebedor(recebedor *Recebedor) error
-	EditarRecebedor(recebedor *Recebedor) error
-	EditarEmailRecebedor(id uint, email string) error
-	DeletarRecebedores(ids []uint) error
-	DeletarRecebedor(id uint) error
-	BuscarChave(chave string) (string, error)
+	BuscarRecebedorPorId(ctx context.Context, id uint) (*Recebedor, error)
+	BuscarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string, limite, offset int) ([]*Recebedor, error)
+	ContarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string) (int, error)
+	BuscarRecebedoresPorChaves(ctx context.Context, chaves []string, limite, offset int) ([]*Recebedor, error)
+	ContarRecebedoresPorChaves(ctx context.Context, chaves []string) (int, error)
+	CriarRecebedor(ctx context.Context, recebedor *Recebedor) error
+	// a alteração do email remove a data de verificação do email
+	EditarRecebedor(ctx context.Context, recebedor *Recebedor) error
+	EditarEmailRecebedor(ctx context.Context, id uint, email string) error
+	EditarStatusRecebedor(ctx context.Context, id uint, status string) error
+	// registra a verificação do email apenas se o recebedor ainda possui o email informado e ele
+	// não foi verificado, retorna se o recebedor foi alterado
+	VerificarEmailRecebedor(ctx context.Context, id uint, email string, verificadoEm time.Time) (bool, error)
+	DeletarRecebedores(ctx context.Context, ids []uint) error
+	DeletarRecebedor(ctx context.Context, id uint) error
+	BuscarChave(ctx context.Context, chave string) (string, error)
 }
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type TipoSuspeita string
+
+const (
+	//o mesmo cpf/cnpj cadastrado com nomes diferentes
+	SuspeitaDocumentoComNomesDivergentes TipoSuspeita = "documento_com_nomes_divergentes"
+	//nomes quase idênticos cadastrados com cpf/cnpj diferentes
+	SuspeitaNomeSemelhante TipoSuspeita = "nome_semelhante"
+	//chave pix de um recebedor deletado cadastrada novamente
+	SuspeitaChaveReutilizada TipoSuspeita = "chave_reutilizada"
+	//email ou chave pix em um domínio de email descartável
+	SuspeitaEmailDescartavel TipoSuspeita = "email_descartavel"
+)
+
+// Suspeita é um indício encontrado no recebedor, a pontuação indica o peso do indício
+type Suspeita struct {
+	Tipo       TipoSuspeita `json:"tipo"`
+	Pontuacao  int          `json:"pontuacao"`
+	Explicacao string       `json:"explicacao"`
+	//recebedores que originaram a suspeita
+	Relacionados []uint `json:"relacionados,omitempty"`
+}
+
+type RecebedorSuspeito struct {
+	Recebedor *Recebedor `json:"recebedor"`
+	//soma das pontuações das suspeitas, limitada a 100
+	Pontuacao int        `json:"pontuacao"`
+	Suspeitas []Suspeita `json:"suspeitas"`
+}
+
+type RelatorioSuspeitos struct {
+	GeradoEm   time.Time           `json:"gerado_em"`
+	Analisados int                 `json:"analisados"`
+	Suspeitos  []RecebedorSuspeito `json:"suspeitos"`
+}
+
+// chave pix de um recebedor deletado
+type ChaveDeletada struct {
+	ChavePix    string    `json:"chave_pix"`
+	RecebedorId uint      `json:"recebedor_id"`
+	CpfCnpj     string    `json:"cpf_cnpj"`
+	Nome        string    `json:"nome"`
+	DeletadoEm  time.Time `json:"deletado_em"`
+}
+
+// HistoricoChaves consulta as chaves dos recebedores deletados
+type HistoricoChaves interface {
+	BuscarChavesDeletadas(ctx context.Context) ([]ChaveDeletada, error)
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// lista de restrição (sanções, PEP, lista interna...) usada na triagem dos recebedores
+type ListaRestritiva struct {
+	Nome     string                   `json:"nome"`
+	Entradas []EntradaListaRestritiva `json:"entradas"`
+}
+
+// pessoa ou empresa restrita, identificada pelo cpf/cnpj, pelo nome ou pelos dois
+type EntradaListaRestritiva struct {
+	CpfCnpj string `json:"cpf_cnpj"`
+	Nome    string `json:"nome"`
+	Motivo  string `json:"motivo"`
+}
+
+// FonteListasRestritivas carrega as listas restritivas, as listas são recarregadas a cada
+// nova triagem dos recebedores existentes
+type FonteListasRestritivas interface {
+	Carregar(ctx context.Context) ([]ListaRestritiva, error)
+}
+
+type CriterioOcorrencia string
+
+const (
+	CriterioDocumento CriterioOcorrencia = "documento"
+	CriterioNome      CriterioOcorrencia = "nome"
+)
+
+type StatusOcorrencia string
+
+const (
+	//aguardando a revisão, o recebedor permanece bloqueado
+	OcorrenciaPendente StatusOcorrencia = "Pendente"
+	//restrição confirmada na revisão, o recebedor permanece bloqueado
+	OcorrenciaConfirmada StatusOcorrencia = "Confirmada"
+	//falso positivo, o recebedor é desbloqueado quando não há outras ocorrências pendentes ou confirmadas
+	OcorrenciaLiberada StatusOcorrencia = "Liberada"
+)
+
+// OcorrenciaTriagem é a correspondência de um recebedor com uma entrada de lista restritiva
+type OcorrenciaTriagem struct {
+	Id          uint                   `json:"id"`
+	RecebedorId uint                   `json:"recebedor_id"`
+	Lista       string                 `json:"lista"`
+	Entrada     EntradaListaRestritiva `json:"entrada"`
+	Criterio    CriterioOcorrencia     `json:"criterio"`
+	//1 para o mesmo cpf/cnpj, a semelhança entre os nomes na correspondência por nome
+	Similaridade float64          `json:"similaridade"`
+	Status       StatusOcorrencia `json:"status"`
+	Observacao   string           `json:"observacao,omitempty"`
+	CriadoEm     time.Time        `json:"criado_em"`
+	RevisadoEm   *time.Time       `json:"revisado_em,omitempty"`
+}
+
+type OcorrenciaRepository interface {
+	CriarOcorrencia(ctx context.Context, ocorrencia *OcorrenciaTriagem) error
+	BuscarOcorrenciaPorId(ctx context.Context, id uint) (*OcorrenciaTriagem, error)
+	// ocorrências com o status informado, todas se vazio, ordenadas pelo id
+	ListarOcorrencias(ctx context.Context, status StatusOcorrencia) ([]*OcorrenciaTriagem, error)
+	BuscarOcorrenciasPorRecebedor(ctx context.Context, recebedorId uint) ([]*OcorrenciaTriagem, error)
+	// altera o status, a observação e a data de revisão da ocorrência
+	RevisarOcorrencia(ctx context.Context, ocorrencia *OcorrenciaTriagem) error
+}
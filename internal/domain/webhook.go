@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type StatusEntrega string
+
+const (
+	EntregaPendente StatusEntrega = "Pendente"
+	EntregaEntregue StatusEntrega = "Entregue"
+	//entrega que esgotou as tentativas, aguardando reenvio manual
+	EntregaFalha StatusEntrega = "Falha"
+)
+
+type Webhook struct {
+	Id  uint   `json:"id"`
+	Url string `json:"url" validate:"required"`
+	//eventos de interesse, vazio para receber todos os eventos
+	Eventos  []TipoEvento `json:"eventos"`
+	Segredo  string       `json:"segredo,omitempty"`
+	CriadoEm time.Time    `json:"criado_em"`
+}
+
+type EntregaWebhook struct {
+	Id               uint          `json:"id"`
+	WebhookId        uint          `json:"webhook_id"`
+	Evento           Evento        `json:"evento"`
+	Status           StatusEntrega `json:"status"`
+	Tentativas       int           `json:"tentativas"`
+	ProximaTentativa time.Time     `json:"proxima_tentativa"`
+	UltimoErro       string        `json:"ultimo_erro,omitempty"`
+	CriadoEm         time.Time     `json:"criado_em"`
+}
+
+type WebhookRepository interface {
+	CriarWebhook(ctx context.Context, webhook *Webhook) error
+	BuscarWebhookPorId(ctx context.Context, id uint) (*Webhook, error)
+	ListarWebhooks(ctx context.Context) ([]*Webhook, error)
+	BuscarWebhooksPorEvento(ctx context.Context, tipo TipoEvento) ([]*Webhook, error)
+	DeletarWebhook(ctx context.Context, id uint) error
+	// cria a entrega do evento para o webhook, a entrega já existente para o mesmo webhook e
+	// evento é mantida, de forma que o evento publicado novamente não seja enviado em dobro
+	CriarEntrega(ctx context.Context, entrega *EntregaWebhook) error
+	BuscarEntregaPorId(ctx context.Context, id uint) (*EntregaWebhook, error)
+	// reserva as entregas pendentes cuja próxima tentativa já venceu, adiando a próxima
+	// tentativa pelo tempo de reserva para que não sejam processadas por outra instância
+	ReservarEntregasPendentes(ctx context.Context, limite int, reserva time.Duration) ([]*EntregaWebhook, error)
+	AtualizarEntrega(ctx context.Context, entrega *EntregaWebhook) error
+	ListarEntregasComFalha(ctx context.Context) ([]*EntregaWebhook, error)
+}
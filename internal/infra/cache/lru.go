@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Backend armazena os valores serializados do cache. A interface permite trocar o LRU em
+// memória por um backend compartilhado entre instâncias, como o Redis
+type Backend interface {
+	// Obter retorna o valor e true se a chave existe e não expirou
+	Obter(ctx context.Context, chave string) ([]byte, bool, error)
+	Gravar(ctx context.Context, chave string, valor []byte, ttl time.Duration) error
+	Remover(ctx context.Context, chaves ...string) error
+}
+
+type entrada struct {
+	chave    string
+	valor    []byte
+	expiraEm time.Time
+}
+
+// LRU é um backend em memória com capacidade limitada, ao atingir a capacidade
+// a chave usada há mais tempo é descartada
+type LRU struct {
+	mu         sync.Mutex
+	capacidade int
+	ordem      *list.List
+	entradas   map[string]*list.Element
+	agora      func() time.Time
+}
+
+func NewLRU(capacidade int) *LRU {
+	return &LRU{
+		capacidade: capacidade,
+		ordem:      list.New(),
+		entradas:   map[string]*list.Element{},
+		agora:      time.Now,
+	}
+}
+
+func (l *LRU) Obter(ctx context.Context, chave string) ([]byte, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	elemento, ok := l.entradas[chave]
+	if !ok {
+		return nil, false, nil
+	}
+	e := elemento.Value.(*entrada)
+	if !l.agora().Before(e.expiraEm) {
+		l.remover(elemento)
+		return nil, false, nil
+	}
+	l.ordem.MoveToFront(elemento)
+	return e.valor, true, nil
+}
+
+func (l *LRU) Gravar(ctx context.Context, chave string, valor []byte, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	expiraEm := l.agora().Add(ttl)
+	if elemento, ok := l.entradas[chave]; ok {
+		e := elemento.Value.(*entrada)
+		e.valor, e.expiraEm = valor, expiraEm
+		l.ordem.MoveToFront(elemento)
+		return nil
+	}
+	l.entradas[chave] = l.ordem.PushFront(&entrada{chave: chave, valor: valor, expiraEm: expiraEm})
+	for l.ordem.Len() > l.capacidade {
+		l.remover(l.ordem.Back())
+	}
+	return nil
+}
+
+func (l *LRU) Remover(ctx context.Context, chaves ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, chave := range chaves {
+		if elemento, ok := l.entradas[chave]; ok {
+			l.remover(elemento)
+		}
+	}
+	return nil
+}
+
+// quantidade de chaves armazenadas, incluindo as expiradas ainda não descartadas
+func (l *LRU) Tamanho() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.ordem.Len()
+}
+
+func (l *LRU) remover(elemento *list.Element) {
+	l.ordem.Remove(elemento)
+	delete(l.entradas, elemento.Value.(*entrada).chave)
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_DescartaMenosUsada(t *testing.T) {
+	ctx := context.Background()
+	lru := NewLRU(2)
+	lru.Gravar(ctx, "a", []byte("1"), time.Minute)
+	lru.Gravar(ctx, "b", []byte("2"), time.Minute)
+	//a leitura torna "a" a mais recente, "b" é descartada ao gravar "c"
+	lru.Obter(ctx, "a")
+	lru.Gravar(ctx, "c", []byte("3"), time.Minute)
+
+	_, ok, _ := lru.Obter(ctx, "b")
+	assert.False(t, ok)
+	valor, ok, _ := lru.Obter(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), valor)
+	assert.Equal(t, 2, lru.Tamanho())
+}
+
+func TestLRU_Expiracao(t *testing.T) {
+	ctx := context.Background()
+	agora := time.Now()
+	lru := NewLRU(10)
+	lru.agora = func() time.Time { return agora }
+	lru.Gravar(ctx, "a", []byte("1"), time.Minute)
+
+	agora = agora.Add(59 * time.Second)
+	_, ok, _ := lru.Obter(ctx, "a")
+	assert.True(t, ok)
+
+	agora = agora.Add(time.Second)
+	_, ok, _ = lru.Obter(ctx, "a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, lru.Tamanho())
+}
+
+func TestLRU_Remover(t *testing.T) {
+	ctx := context.Background()
+	lru := NewLRU(10)
+	lru.Gravar(ctx, "a", []byte("1"), time.Minute)
+	lru.Gravar(ctx, "b", []byte("2"), time.Minute)
+	assert.NoError(t, lru.Remover(ctx, "a", "inexistente"))
+
+	_, ok, _ := lru.Obter(ctx, "a")
+	assert.False(t, ok)
+	_, ok, _ = lru.Obter(ctx, "b")
+	assert.True(t, ok)
+}
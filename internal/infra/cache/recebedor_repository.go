@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+)
+
+// recebedorRepository decora um domain.RecebedorRepository com cache de leitura das buscas
+// por id e por chave pix. Apenas os recebedores e chaves encontrados são armazenados, e
+// toda alteração remove do cache o id e as chaves pix afetadas. As demais operações são
+// repassadas ao repositório decorado
+type recebedorRepository struct {
+	domain.RecebedorRepository
+	backend Backend
+	ttl     time.Duration
+}
+
+func NewRecebedorRepository(repo domain.RecebedorRepository, backend Backend, ttl time.Duration) *recebedorRepository {
+	return &recebedorRepository{RecebedorRepository: repo, backend: backend, ttl: ttl}
+}
+
+func chaveId(id uint) string {
+	return fmt.Sprintf("recebedor:id:%d", id)
+}
+
+func chavePix(chave string) string {
+	return "recebedor:chave:" + chave
+}
+
+// consulta o backend, erros do backend são tratados como falha de cache
+func (r *recebedorRepository) obter(ctx context.Context, operacao, chave string) ([]byte, bool) {
+	valor, ok, err := r.backend.Obter(ctx, chave)
+	resultado := "acerto"
+	if err != nil || !ok {
+		resultado = "falha"
+	}
+	metricas.ConsultasCache.WithLabelValues(operacao, resultado).Inc()
+	return valor, err == nil && ok
+}
+
+// remove as chaves do cache, a alteração já foi realizada e um erro do backend
+// apenas mantém o valor antigo até expirar o ttl
+func (r *recebedorRepository) invalidar(ctx context.Context, chaves ...string) {
+	r.backend.Remover(ctx, chaves...)
+}
+
+func (r *recebedorRepository) BuscarRecebedorPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	if valor, ok := r.obter(ctx, "BuscarRecebedorPorId", chaveId(id)); ok {
+		var recebedor domain.Recebedor
+		if err := json.Unmarshal(valor, &recebedor); err == nil {
+			return &recebedor, nil
+		}
+	}
+	recebedor, err := r.RecebedorRepository.BuscarRecebedorPorId(ctx, id)
+	if err != nil || recebedor == nil {
+		return recebedor, err
+	}
+	if valor, err := json.Marshal(recebedor); err == nil {
+		r.backend.Gravar(ctx, chaveId(id), valor, r.ttl)
+	}
+	return recebedor, nil
+}
+
+func (r *recebedorRepository) BuscarChave(ctx context.Context, chave string) (string, error) {
+	if valor, ok := r.obter(ctx, "BuscarChave", chavePix(chave)); ok {
+		return string(valor), nil
+	}
+	encontrada, err := r.RecebedorRepository.BuscarChave(ctx, chave)
+	if err != nil || encontrada == "" {
+		return encontrada, err
+	}
+	r.backend.Gravar(ctx, chavePix(chave), []byte(encontrada), r.ttl)
+	return encontrada, nil
+}
+
+// chaves do cache do recebedor antes da alteração, incluindo a chave pix atual. O recebedor é lido do
+// repositório decorado, pois o valor em cache pode estar desatualizado e não conter a chave atual
+func (r *recebedorRepository) chavesAfetadas(ctx context.Context, id uint) ([]string, error) {
+	chaves := []string{chaveId(id)}
+	atual, err := r.RecebedorRepository.BuscarRecebedorPorId(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if atual != nil {
+		chaves = append(chaves, chavePix(atual.ChavePix))
+	}
+	return chaves, nil
+}
+
+func (r *recebedorRepository) CriarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	if err := r.RecebedorRepository.CriarRecebedor(ctx, recebedor); err != nil {
+		return err
+	}
+	r.invalidar(ctx, chaveId(recebedor.Id), chavePix(recebedor.ChavePix))
+	return nil
+}
+
+func (r *recebedorRepository) EditarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	chaves, err := r.chavesAfetadas(ctx, recebedor.Id)
+	if err != nil {
+		return err
+	}
+	if err := r.RecebedorRepository.EditarRecebedor(ctx, recebedor); err != nil {
+		return err
+	}
+	if recebedor.ChavePix != "" {
+		chaves = append(chaves, chavePix(recebedor.ChavePix))
+	}
+	r.invalidar(ctx, chaves...)
+	return nil
+}
+
+func (r *recebedorRepository) EditarEmailRecebedor(ctx context.Context, id uint, email string) error {
+	if err := r.RecebedorRepository.EditarEmailRecebedor(ctx, id, email); err != nil {
+		return err
+	}
+	r.invalidar(ctx, chaveId(id))
+	return nil
+}
+
+func (r *recebedorRepository) VerificarEmailRecebedor(ctx context.Context, id uint, email string, verificadoEm time.Time) (bool, error) {
+	verificado, err := r.RecebedorRepository.VerificarEmailRecebedor(ctx, id, email, verificadoEm)
+	if err != nil {
+		return false, err
+	}
+	if verificado {
+		r.invalidar(ctx, chaveId(id))
+	}
+	return verificado, nil
+}
+
+func (r *recebedorRepository) EditarStatusRecebedor(ctx context.Context, id uint, status string) error {
+	if err := r.RecebedorRepository.EditarStatusRecebedor(ctx, id, status); err != nil {
+		return err
+	}
+	r.invalidar(ctx, chaveId(id))
+	return nil
+}
+
+func (r *recebedorRepository) DeletarRecebedor(ctx context.Context, id uint) error {
+	chaves, err := r.chavesAfetadas(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := r.RecebedorRepository.DeletarRecebedor(ctx, id); err != nil {
+		return err
+	}
+	r.invalidar(ctx, chaves...)
+	return nil
+}
+
+// Deprecated: não utilizar
+func (r *recebedorRepository) DeletarRecebedores(ctx context.Context, ids []uint) error {
+	chaves := []string{}
+	for _, id := range ids {
+		afetadas, err := r.chavesAfetadas(ctx, id)
+		if err != nil {
+			return err
+		}
+		chaves = append(chaves, afetadas...)
+	}
+	if err := r.RecebedorRepository.DeletarRecebedores(ctx, ids); err != nil {
+		return err
+	}
+	r.invalidar(ctx, chaves...)
+	return nil
+}
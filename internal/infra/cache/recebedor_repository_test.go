@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/contrato"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conta as consultas que chegam ao repositório decorado
+type repositorioContador struct {
+	domain.RecebedorRepository
+	porId, porChave int
+}
+
+func (r *repositorioContador) BuscarRecebedorPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	r.porId++
+	return r.RecebedorRepository.BuscarRecebedorPorId(ctx, id)
+}
+
+func (r *repositorioContador) BuscarChave(ctx context.Context, chave string) (string, error) {
+	r.porChave++
+	return r.RecebedorRepository.BuscarChave(ctx, chave)
+}
+
+func novoRecebedor() *domain.Recebedor {
+	return &domain.Recebedor{CpfCnpj: "38836148077", Nome: "ana", TipoChavePix: domain.Cpf, ChavePix: "38836148077", Status: domain.StatusRascunho}
+}
+
+func TestRecebedorRepository_Contrato(t *testing.T) {
+	contrato.TestarRecebedorRepository(t, func(t *testing.T) domain.RecebedorRepository {
+		return NewRecebedorRepository(memoria.NewRecebedorRepository(), NewLRU(100), time.Minute)
+	})
+}
+
+func TestRecebedorRepository_BuscarPorIdUsaCache(t *testing.T) {
+	ctx := context.Background()
+	contador := &repositorioContador{RecebedorRepository: memoria.NewRecebedorRepository()}
+	repo := NewRecebedorRepository(contador, NewLRU(100), time.Minute)
+	recebedor := novoRecebedor()
+	require.NoError(t, repo.CriarRecebedor(ctx, recebedor))
+	acertos := testutil.ToFloat64(metricas.ConsultasCache.WithLabelValues("BuscarRecebedorPorId", "acerto"))
+
+	for i := 0; i < 3; i++ {
+		encontrado, err := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		assert.Equal(t, recebedor, encontrado)
+	}
+	assert.Equal(t, 1, contador.porId)
+	assert.Equal(t, acertos+2, testutil.ToFloat64(metricas.ConsultasCache.WithLabelValues("BuscarRecebedorPorId", "acerto")))
+
+	//recebedores inexistentes não são armazenados
+	repo.BuscarRecebedorPorId(ctx, 99)
+	repo.BuscarRecebedorPorId(ctx, 99)
+	assert.Equal(t, 3, contador.porId)
+}
+
+func TestRecebedorRepository_AlteracaoInvalidaCache(t *testing.T) {
+	ctx := context.Background()
+	contador := &repositorioContador{RecebedorRepository: memoria.NewRecebedorRepository()}
+	repo := NewRecebedorRepository(contador, NewLRU(100), time.Minute)
+	recebedor := novoRecebedor()
+	require.NoError(t, repo.CriarRecebedor(ctx, recebedor))
+
+	chave, _ := repo.BuscarChave(ctx, recebedor.ChavePix)
+	assert.Equal(t, recebedor.ChavePix, chave)
+	repo.BuscarChave(ctx, recebedor.ChavePix)
+	assert.Equal(t, 1, contador.porChave)
+
+	require.NoError(t, repo.EditarRecebedor(ctx, &domain.Recebedor{Id: recebedor.Id, TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}))
+	chave, _ = repo.BuscarChave(ctx, recebedor.ChavePix)
+	assert.Empty(t, chave)
+	editado, _ := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+	assert.Equal(t, "ana@transfeera.com", editado.ChavePix)
+
+	require.NoError(t, repo.EditarStatusRecebedor(ctx, recebedor.Id, domain.StatusValidado))
+	editado, _ = repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+	assert.Equal(t, domain.StatusValidado, editado.Status)
+
+	require.NoError(t, repo.DeletarRecebedor(ctx, recebedor.Id))
+	deletado, _ := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+	assert.Nil(t, deletado)
+	chave, _ = repo.BuscarChave(ctx, "ana@transfeera.com")
+	assert.Empty(t, chave)
+}
+
+func TestRecebedorRepository_AlteracaoComCacheDesatualizado(t *testing.T) {
+	ctx := context.Background()
+	decorado := memoria.NewRecebedorRepository()
+	repo := NewRecebedorRepository(decorado, NewLRU(100), time.Minute)
+	recebedor := novoRecebedor()
+	require.NoError(t, repo.CriarRecebedor(ctx, recebedor))
+	repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+
+	//alteração realizada por outra instância, o recebedor em cache ainda tem a chave anterior
+	require.NoError(t, decorado.EditarRecebedor(ctx, &domain.Recebedor{Id: recebedor.Id, TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}))
+	chave, _ := repo.BuscarChave(ctx, "ana@transfeera.com")
+	assert.Equal(t, "ana@transfeera.com", chave)
+
+	require.NoError(t, repo.EditarRecebedor(ctx, &domain.Recebedor{Id: recebedor.Id, ChavePix: "ana.maria@transfeera.com"}))
+	chave, _ = repo.BuscarChave(ctx, "ana@transfeera.com")
+	assert.Empty(t, chave)
+}
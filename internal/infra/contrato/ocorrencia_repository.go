@@ -0,0 +1,85 @@
+package contrato
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NovoOcorrenciaRepository retorna um repositório de ocorrências vazio, isolado dos demais testes
+type NovoOcorrenciaRepository func(t *testing.T) domain.OcorrenciaRepository
+
+func novaOcorrencia(recebedorId uint, nome string) *domain.OcorrenciaTriagem {
+	return &domain.OcorrenciaTriagem{
+		RecebedorId:  recebedorId,
+		Lista:        "sancoes",
+		Entrada:      domain.EntradaListaRestritiva{CpfCnpj: "783.852.830-56", Nome: nome, Motivo: "sanção"},
+		Criterio:     domain.CriterioNome,
+		Similaridade: 0.95,
+		Status:       domain.OcorrenciaPendente,
+	}
+}
+
+// TestarOcorrenciaRepository executa o contrato do repositório de ocorrências da triagem
+func TestarOcorrenciaRepository(t *testing.T, novo NovoOcorrenciaRepository) {
+	ctx := context.Background()
+
+	t.Run("criar e buscar", func(t *testing.T) {
+		repo := novo(t)
+		ocorrencia := novaOcorrencia(1, "ana")
+		require.NoError(t, repo.CriarOcorrencia(ctx, ocorrencia))
+		assert.NotZero(t, ocorrencia.Id)
+		assert.False(t, ocorrencia.CriadoEm.IsZero())
+
+		encontrada, err := repo.BuscarOcorrenciaPorId(ctx, ocorrencia.Id)
+		require.NoError(t, err)
+		assert.Equal(t, ocorrencia.Entrada, encontrada.Entrada)
+		assert.Equal(t, ocorrencia.Lista, encontrada.Lista)
+		assert.Equal(t, domain.CriterioNome, encontrada.Criterio)
+		assert.InDelta(t, 0.95, encontrada.Similaridade, 0.0001)
+		assert.Equal(t, domain.OcorrenciaPendente, encontrada.Status)
+		assert.Nil(t, encontrada.RevisadoEm)
+
+		inexistente, err := repo.BuscarOcorrenciaPorId(ctx, ocorrencia.Id+100)
+		assert.NoError(t, err)
+		assert.Nil(t, inexistente)
+	})
+
+	t.Run("listar por status e por recebedor", func(t *testing.T) {
+		repo := novo(t)
+		primeira, segunda, outra := novaOcorrencia(1, "ana"), novaOcorrencia(1, "ana maria"), novaOcorrencia(2, "pedro")
+		for _, ocorrencia := range []*domain.OcorrenciaTriagem{primeira, segunda, outra} {
+			require.NoError(t, repo.CriarOcorrencia(ctx, ocorrencia))
+		}
+		revisadoEm := time.Now().UTC().Truncate(time.Second)
+		segunda.Status, segunda.Observacao, segunda.RevisadoEm = domain.OcorrenciaLiberada, "homônimo", &revisadoEm
+		require.NoError(t, repo.RevisarOcorrencia(ctx, segunda))
+
+		pendentes, err := repo.ListarOcorrencias(ctx, domain.OcorrenciaPendente)
+		require.NoError(t, err)
+		assert.Equal(t, []uint{primeira.Id, outra.Id}, idsOcorrencias(pendentes))
+		todas, err := repo.ListarOcorrencias(ctx, "")
+		require.NoError(t, err)
+		assert.Len(t, todas, 3)
+
+		doRecebedor, err := repo.BuscarOcorrenciasPorRecebedor(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, []uint{primeira.Id, segunda.Id}, idsOcorrencias(doRecebedor))
+		assert.Equal(t, domain.OcorrenciaLiberada, doRecebedor[1].Status)
+		assert.Equal(t, "homônimo", doRecebedor[1].Observacao)
+		require.NotNil(t, doRecebedor[1].RevisadoEm)
+		assert.True(t, revisadoEm.Equal(*doRecebedor[1].RevisadoEm))
+	})
+}
+
+func idsOcorrencias(ocorrencias []*domain.OcorrenciaTriagem) []uint {
+	resultado := []uint{}
+	for _, ocorrencia := range ocorrencias {
+		resultado = append(resultado, ocorrencia.Id)
+	}
+	return resultado
+}
@@ -0,0 +1,321 @@
+// Package contrato reúne os testes de contrato do domain.RecebedorRepository, executados
+// contra todas as implementações para garantir que elas tenham a mesma semântica
+package contrato
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NovoRepositorio retorna um repositório vazio, isolado dos demais testes
+type NovoRepositorio func(t *testing.T) domain.RecebedorRepository
+
+func novoRecebedor(nome, tipo, chave string) *domain.Recebedor {
+	return &domain.Recebedor{
+		CpfCnpj:      "783.852.830-56",
+		Nome:         nome,
+		TipoChavePix: domain.TipoChavePix(tipo),
+		ChavePix:     chave,
+		Status:       domain.StatusRascunho,
+		Email:        nome + "@transfeera.com",
+	}
+}
+
+func criar(t *testing.T, repo domain.RecebedorRepository, recebedores ...*domain.Recebedor) {
+	for _, recebedor := range recebedores {
+		require.NoError(t, repo.CriarRecebedor(context.Background(), recebedor))
+	}
+}
+
+func ids(recebedores []*domain.Recebedor) []uint {
+	resultado := []uint{}
+	for _, recebedor := range recebedores {
+		resultado = append(resultado, recebedor.Id)
+	}
+	return resultado
+}
+
+// TestarRecebedorRepository executa o contrato do repositório de recebedores
+func TestarRecebedorRepository(t *testing.T, novo NovoRepositorio) {
+	ctx := context.Background()
+
+	t.Run("criar e buscar por id", func(t *testing.T) {
+		repo := novo(t)
+		primeiro := novoRecebedor("ana", "CPF", "388.361.480-77")
+		segundo := novoRecebedor("pedro", "EMAIL", "pedro@transfeera.com")
+		criar(t, repo, primeiro, segundo)
+		assert.NotZero(t, primeiro.Id)
+		assert.Greater(t, segundo.Id, primeiro.Id)
+
+		encontrado, err := repo.BuscarRecebedorPorId(ctx, primeiro.Id)
+		require.NoError(t, err)
+		assert.Equal(t, primeiro, encontrado)
+
+		inexistente, err := repo.BuscarRecebedorPorId(ctx, segundo.Id+100)
+		assert.NoError(t, err)
+		assert.Nil(t, inexistente)
+	})
+
+	t.Run("tipo de chave inexistente", func(t *testing.T) {
+		repo := novo(t)
+		assert.Error(t, repo.CriarRecebedor(ctx, novoRecebedor("ana", "PIX", "388.361.480-77")))
+	})
+
+	t.Run("chave pix duplicada", func(t *testing.T) {
+		repo := novo(t)
+		ana := novoRecebedor("ana", "CPF", "388.361.480-77")
+		pedro := novoRecebedor("pedro", "EMAIL", "pedro@transfeera.com")
+		criar(t, repo, ana, pedro)
+
+		err := repo.CriarRecebedor(ctx, novoRecebedor("maria", "CPF", "388.361.480-77"))
+		assert.ErrorIs(t, err, domain.ErrChavePixJaCadastrada)
+		err = repo.EditarRecebedor(ctx, &domain.Recebedor{Id: pedro.Id, ChavePix: "388.361.480-77"})
+		assert.ErrorIs(t, err, domain.ErrChavePixJaCadastrada)
+		//a própria chave pode ser informada na edição
+		assert.NoError(t, repo.EditarRecebedor(ctx, &domain.Recebedor{Id: ana.Id, ChavePix: "388.361.480-77"}))
+
+		total, err := repo.ContarRecebedoresPorCampo(ctx, "388.361.480-77", "chave_pix")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		editado, err := repo.BuscarRecebedorPorId(ctx, pedro.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "pedro@transfeera.com", editado.ChavePix)
+	})
+
+	t.Run("buscar chave", func(t *testing.T) {
+		repo := novo(t)
+		criar(t, repo, novoRecebedor("ana", "CPF", "388.361.480-77"))
+
+		chave, err := repo.BuscarChave(ctx, "388.361.480-77")
+		assert.NoError(t, err)
+		assert.Equal(t, "388.361.480-77", chave)
+
+		chave, err = repo.BuscarChave(ctx, "994.405.470-49")
+		assert.NoError(t, err)
+		assert.Empty(t, chave)
+	})
+
+	t.Run("buscar e contar por campo com paginação", func(t *testing.T) {
+		repo := novo(t)
+		recebedores := []*domain.Recebedor{}
+		for i := 0; i < 5; i++ {
+			recebedores = append(recebedores, novoRecebedor("maria", "EMAIL", fmt.Sprintf("maria%d@transfeera.com", i)))
+		}
+		criar(t, repo, recebedores...)
+		criar(t, repo, novoRecebedor("joao", "EMAIL", "joao@transfeera.com"))
+
+		total, err := repo.ContarRecebedoresPorCampo(ctx, "maria", "nome")
+		assert.NoError(t, err)
+		assert.Equal(t, 5, total)
+
+		pagina, err := repo.BuscarRecebedoresPorCampo(ctx, "maria", "nome", 2, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{recebedores[2].Id, recebedores[3].Id}, ids(pagina))
+
+		ultima, err := repo.BuscarRecebedoresPorCampo(ctx, "maria", "nome", 2, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{recebedores[4].Id}, ids(ultima))
+
+		porStatus, err := repo.ContarRecebedoresPorCampo(ctx, domain.StatusRascunho, "status_recebedor")
+		assert.NoError(t, err)
+		assert.Equal(t, 6, porStatus)
+
+		porTipo, err := repo.BuscarRecebedoresPorCampo(ctx, "EMAIL", "tipo_chave_pix", 10, 0)
+		assert.NoError(t, err)
+		assert.Len(t, porTipo, 6)
+
+		nenhum, err := repo.BuscarRecebedoresPorCampo(ctx, "carlos", "nome", 10, 0)
+		assert.NoError(t, err)
+		assert.NotNil(t, nenhum)
+		assert.Empty(t, nenhum)
+	})
+
+	t.Run("buscar e contar por chaves", func(t *testing.T) {
+		repo := novo(t)
+		cpf := novoRecebedor("ana", "CPF", "38836148077")
+		telefone := novoRecebedor("pedro", "TELEFONE", "+5538836148077")
+		criar(t, repo, cpf, telefone, novoRecebedor("joao", "EMAIL", "joao@transfeera.com"))
+		chaves := []string{"38836148077", "+5538836148077", "inexistente"}
+
+		total, err := repo.ContarRecebedoresPorChaves(ctx, chaves)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+
+		encontrados, err := repo.BuscarRecebedoresPorChaves(ctx, chaves, 10, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{cpf.Id, telefone.Id}, ids(encontrados))
+
+		pagina, err := repo.BuscarRecebedoresPorChaves(ctx, chaves, 1, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint{telefone.Id}, ids(pagina))
+	})
+
+	t.Run("editar apenas os campos informados", func(t *testing.T) {
+		repo := novo(t)
+		recebedor := novoRecebedor("ana", "CPF", "388.361.480-77")
+		criar(t, repo, recebedor)
+
+		err := repo.EditarRecebedor(ctx, &domain.Recebedor{Id: recebedor.Id, Nome: "ana maria", TipoChavePix: "EMAIL", ChavePix: "ana@transfeera.com"})
+		require.NoError(t, err)
+
+		editado, err := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "ana maria", editado.Nome)
+		assert.Equal(t, domain.TipoChavePix("EMAIL"), editado.TipoChavePix)
+		assert.Equal(t, "ana@transfeera.com", editado.ChavePix)
+		assert.Equal(t, recebedor.CpfCnpj, editado.CpfCnpj)
+		assert.Equal(t, recebedor.Email, editado.Email)
+		assert.Equal(t, recebedor.Status, editado.Status)
+	})
+
+	t.Run("editar email e status", func(t *testing.T) {
+		repo := novo(t)
+		recebedor := novoRecebedor("ana", "CPF", "388.361.480-77")
+		criar(t, repo, recebedor)
+
+		require.NoError(t, repo.EditarEmailRecebedor(ctx, recebedor.Id, "novo@transfeera.com"))
+		require.NoError(t, repo.EditarStatusRecebedor(ctx, recebedor.Id, domain.StatusValidado))
+
+		editado, err := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "novo@transfeera.com", editado.Email)
+		assert.Equal(t, domain.StatusValidado, editado.Status)
+
+		//alterar um recebedor inexistente não é um erro
+		assert.NoError(t, repo.EditarEmailRecebedor(ctx, recebedor.Id+100, "novo@transfeera.com"))
+		assert.NoError(t, repo.EditarStatusRecebedor(ctx, recebedor.Id+100, domain.StatusValidado))
+	})
+
+	t.Run("verificar email", func(t *testing.T) {
+		repo := novo(t)
+		recebedor := novoRecebedor("ana", "CPF", "388.361.480-77")
+		criar(t, repo, recebedor)
+		verificadoEm := time.Now().UTC().Truncate(time.Second)
+
+		//o email informado deve ser o email atual do recebedor
+		verificado, err := repo.VerificarEmailRecebedor(ctx, recebedor.Id, "outro@transfeera.com", verificadoEm)
+		require.NoError(t, err)
+		assert.False(t, verificado)
+		verificado, err = repo.VerificarEmailRecebedor(ctx, recebedor.Id, recebedor.Email, verificadoEm)
+		require.NoError(t, err)
+		assert.True(t, verificado)
+		encontrado, err := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		require.NotNil(t, encontrado.EmailVerificadoEm)
+		assert.True(t, verificadoEm.Equal(*encontrado.EmailVerificadoEm))
+		//as buscas retornam a verificação
+		porNome, err := repo.BuscarRecebedoresPorCampo(ctx, recebedor.Nome, "nome", 10, 0)
+		require.NoError(t, err)
+		require.Len(t, porNome, 1)
+		assert.NotNil(t, porNome[0].EmailVerificadoEm)
+		porChave, err := repo.BuscarRecebedoresPorChaves(ctx, []string{recebedor.ChavePix}, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, porChave, 1)
+		assert.NotNil(t, porChave[0].EmailVerificadoEm)
+
+		//a verificação é registrada uma única vez
+		verificado, err = repo.VerificarEmailRecebedor(ctx, recebedor.Id, recebedor.Email, verificadoEm.Add(time.Hour))
+		require.NoError(t, err)
+		assert.False(t, verificado)
+
+		//editar o recebedor sem alterar o email mantém a verificação
+		require.NoError(t, repo.EditarRecebedor(ctx, &domain.Recebedor{Id: recebedor.Id, Nome: "ana maria", Email: recebedor.Email}))
+		require.NoError(t, repo.EditarEmailRecebedor(ctx, recebedor.Id, recebedor.Email))
+		encontrado, err = repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		assert.NotNil(t, encontrado.EmailVerificadoEm)
+
+		require.NoError(t, repo.EditarEmailRecebedor(ctx, recebedor.Id, "novo@transfeera.com"))
+		encontrado, err = repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		assert.Nil(t, encontrado.EmailVerificadoEm)
+		verificado, err = repo.VerificarEmailRecebedor(ctx, recebedor.Id, "novo@transfeera.com", verificadoEm)
+		require.NoError(t, err)
+		assert.True(t, verificado)
+		require.NoError(t, repo.EditarRecebedor(ctx, &domain.Recebedor{Id: recebedor.Id, Email: "ana@transfeera.com"}))
+		encontrado, err = repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		require.NoError(t, err)
+		assert.Nil(t, encontrado.EmailVerificadoEm)
+
+		verificado, err = repo.VerificarEmailRecebedor(ctx, recebedor.Id+100, recebedor.Email, verificadoEm)
+		assert.NoError(t, err)
+		assert.False(t, verificado)
+	})
+
+	t.Run("deletar", func(t *testing.T) {
+		repo := novo(t)
+		recebedor := novoRecebedor("ana", "CPF", "388.361.480-77")
+		outro := novoRecebedor("pedro", "CPF", "994.405.470-49")
+		criar(t, repo, recebedor, outro)
+
+		require.NoError(t, repo.DeletarRecebedor(ctx, recebedor.Id))
+		deletado, err := repo.BuscarRecebedorPorId(ctx, recebedor.Id)
+		assert.NoError(t, err)
+		assert.Nil(t, deletado)
+		assert.NoError(t, repo.DeletarRecebedor(ctx, recebedor.Id))
+
+		chave, err := repo.BuscarChave(ctx, recebedor.ChavePix)
+		assert.NoError(t, err)
+		assert.Empty(t, chave)
+
+		mantido, err := repo.BuscarRecebedorPorId(ctx, outro.Id)
+		assert.NoError(t, err)
+		assert.NotNil(t, mantido)
+	})
+
+	t.Run("chaves deletadas", func(t *testing.T) {
+		repo := novo(t)
+		historico, ok := repo.(domain.HistoricoChaves)
+		if !ok {
+			t.Skip("o repositório não mantém o histórico de chaves")
+		}
+		recebedor := novoRecebedor("ana", "CPF", "388.361.480-77")
+		outros := []*domain.Recebedor{novoRecebedor("pedro", "CPF", "994.405.470-49"), novoRecebedor("maria", "EMAIL", "maria@transfeera.com")}
+		criar(t, repo, append(outros, recebedor)...)
+		require.NoError(t, repo.DeletarRecebedor(ctx, recebedor.Id))
+		require.NoError(t, repo.DeletarRecebedores(ctx, ids(outros)))
+
+		deletadas, err := historico.BuscarChavesDeletadas(ctx)
+		require.NoError(t, err)
+		require.Len(t, deletadas, 3)
+		chaves := map[string]domain.ChaveDeletada{}
+		for _, deletada := range deletadas {
+			chaves[deletada.ChavePix] = deletada
+		}
+		assert.Equal(t, recebedor.Id, chaves[recebedor.ChavePix].RecebedorId)
+		assert.Equal(t, "ana", chaves[recebedor.ChavePix].Nome)
+		assert.Equal(t, recebedor.CpfCnpj, chaves[recebedor.ChavePix].CpfCnpj)
+		assert.False(t, chaves["maria@transfeera.com"].DeletadoEm.IsZero())
+	})
+
+	t.Run("criações concorrentes", func(t *testing.T) {
+		repo := novo(t)
+		var wg sync.WaitGroup
+		recebedores := make([]*domain.Recebedor, 20)
+		for i := range recebedores {
+			recebedores[i] = novoRecebedor("maria", "EMAIL", fmt.Sprintf("maria%d@transfeera.com", i))
+			wg.Add(1)
+			go func(recebedor *domain.Recebedor) {
+				defer wg.Done()
+				assert.NoError(t, repo.CriarRecebedor(ctx, recebedor))
+			}(recebedores[i])
+		}
+		wg.Wait()
+
+		unicos := map[uint]bool{}
+		for _, recebedor := range recebedores {
+			unicos[recebedor.Id] = true
+		}
+		assert.Len(t, unicos, len(recebedores))
+		total, err := repo.ContarRecebedoresPorCampo(ctx, "maria", "nome")
+		assert.NoError(t, err)
+		assert.Equal(t, len(recebedores), total)
+	})
+}
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/limite"
+)
+
+// o balde é reabastecido e consumido em um único upsert, o que torna o consumo atômico
+// entre as réplicas. A coluna permitido registra se o último consumo obteve um token
+const queryConsumirLimite = `
+INSERT INTO pagamento.limite_requisicoes AS l (chave, tokens, permitido, atualizado_em)
+VALUES ($1, $2::float8 - 1, true, now())
+ON CONFLICT (chave) DO UPDATE SET
+	tokens = LEAST($2::float8, l.tokens + EXTRACT(EPOCH FROM now() - l.atualizado_em) * $3::float8)
+		- CASE WHEN LEAST($2::float8, l.tokens + EXTRACT(EPOCH FROM now() - l.atualizado_em) * $3::float8) >= 1 THEN 1 ELSE 0 END,
+	permitido = LEAST($2::float8, l.tokens + EXTRACT(EPOCH FROM now() - l.atualizado_em) * $3::float8) >= 1,
+	atualizado_em = now()
+RETURNING tokens, permitido`
+
+// LimitadorPostgres compartilha os baldes do limite de requisições entre as réplicas da aplicação
+type LimitadorPostgres struct {
+	db            *sql.DB
+	mu            sync.Mutex
+	ultimaLimpeza time.Time
+}
+
+func NewLimitadorPostgres(db *sql.DB) *LimitadorPostgres {
+	return &LimitadorPostgres{db: db, ultimaLimpeza: time.Now()}
+}
+
+func (l *LimitadorPostgres) Consumir(ctx context.Context, chave string, maximo int, janela time.Duration) (limite.Resultado, error) {
+	l.limpar(ctx, janela)
+	var tokens float64
+	var permitido bool
+	taxa := float64(maximo) / janela.Seconds()
+	if err := l.db.QueryRowContext(ctx, queryConsumirLimite, chave, maximo, taxa).Scan(&tokens, &permitido); err != nil {
+		return limite.Resultado{}, err
+	}
+	return limite.NovoResultado(permitido, tokens, maximo, janela), nil
+}
+
+// remove a cada janela os baldes sem uso há mais de uma janela, que já estariam cheios
+func (l *LimitadorPostgres) limpar(ctx context.Context, janela time.Duration) {
+	l.mu.Lock()
+	if time.Since(l.ultimaLimpeza) < janela {
+		l.mu.Unlock()
+		return
+	}
+	l.ultimaLimpeza = time.Now()
+	l.mu.Unlock()
+	l.db.ExecContext(ctx, "DELETE FROM pagamento.limite_requisicoes WHERE atualizado_em < now() - $1 * interval '1 second'", janela.Seconds())
+}
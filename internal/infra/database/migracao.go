@@ -0,0 +1,225 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+//go:embed migracoes/*.sql
+var arquivosMigracoes embed.FS
+
+// impede que duas instâncias apliquem as migrações ao mesmo tempo
+const lockMigracoes = 7251040
+
+// arquivos no formato 0001_descricao.up.sql e 0001_descricao.down.sql
+var regexMigracao = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migracao versionada do esquema do banco de dados
+type Migracao struct {
+	Versao int
+	Nome   string
+	subir  string
+	descer string
+}
+
+// StatusMigracao indica se a migração foi aplicada e quando
+type StatusMigracao struct {
+	Migracao
+	AplicadaEm *time.Time
+}
+
+type Migrador struct {
+	db        *sql.DB
+	migracoes []Migracao
+}
+
+// NewMigrador carrega as migrações embutidas no binário, ordenadas pela versão
+func NewMigrador(db *sql.DB) (*Migrador, error) {
+	migracoes, err := carregarMigracoes(arquivosMigracoes)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrador{db: db, migracoes: migracoes}, nil
+}
+
+func carregarMigracoes(arquivos fs.FS) ([]Migracao, error) {
+	caminhos, err := fs.Glob(arquivos, "migracoes/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	porVersao := map[int]*Migracao{}
+	for _, caminho := range caminhos {
+		partes := regexMigracao.FindStringSubmatch(path.Base(caminho))
+		if partes == nil {
+			return nil, fmt.Errorf("nome de migração inválido: %s", caminho)
+		}
+		versao, _ := strconv.Atoi(partes[1])
+		conteudo, err := fs.ReadFile(arquivos, caminho)
+		if err != nil {
+			return nil, err
+		}
+		migracao, ok := porVersao[versao]
+		if !ok {
+			migracao = &Migracao{Versao: versao, Nome: partes[2]}
+			porVersao[versao] = migracao
+		}
+		if migracao.Nome != partes[2] {
+			return nil, fmt.Errorf("versão %d usada pelas migrações %s e %s", versao, migracao.Nome, partes[2])
+		}
+		if partes[3] == "up" {
+			migracao.subir = string(conteudo)
+		} else {
+			migracao.descer = string(conteudo)
+		}
+	}
+	migracoes := make([]Migracao, 0, len(porVersao))
+	for _, migracao := range porVersao {
+		if migracao.subir == "" || migracao.descer == "" {
+			return nil, fmt.Errorf("migração %d_%s precisa dos arquivos up e down", migracao.Versao, migracao.Nome)
+		}
+		migracoes = append(migracoes, *migracao)
+	}
+	sort.Slice(migracoes, func(i, j int) bool { return migracoes[i].Versao < migracoes[j].Versao })
+	return migracoes, nil
+}
+
+// VersaoMaisRecente retorna a versão da última migração embutida no binário
+func (m *Migrador) VersaoMaisRecente() int {
+	if len(m.migracoes) == 0 {
+		return 0
+	}
+	return m.migracoes[len(m.migracoes)-1].Versao
+}
+
+// executa fn com o lock das migrações e a tabela de controle criada
+func (m *Migrador) comLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockMigracoes); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockMigracoes)
+	query := "CREATE TABLE IF NOT EXISTS public.schema_migrations (versao INTEGER PRIMARY KEY, nome VARCHAR(255) NOT NULL, aplicada_em TIMESTAMPTZ NOT NULL DEFAULT now())"
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// versões aplicadas com a data de aplicação
+func aplicadas(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT versao, aplicada_em FROM public.schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	versoes := map[int]time.Time{}
+	for rows.Next() {
+		var versao int
+		var aplicadaEm time.Time
+		if err := rows.Scan(&versao, &aplicadaEm); err != nil {
+			return nil, err
+		}
+		versoes[versao] = aplicadaEm
+	}
+	return versoes, rows.Err()
+}
+
+// executa o sql da migração e atualiza a tabela de controle na mesma transação
+func executarMigracao(ctx context.Context, conn *sql.Conn, script, controle string, args ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, controle, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Subir aplica as migrações pendentes em ordem, cada uma em sua transação,
+// e retorna as migrações aplicadas
+func (m *Migrador) Subir(ctx context.Context) ([]Migracao, error) {
+	executadas := []Migracao{}
+	err := m.comLock(ctx, func(conn *sql.Conn) error {
+		versoes, err := aplicadas(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migracao := range m.migracoes {
+			if _, ok := versoes[migracao.Versao]; ok {
+				continue
+			}
+			err := executarMigracao(ctx, conn, migracao.subir,
+				"INSERT INTO public.schema_migrations (versao, nome) VALUES ($1, $2)", migracao.Versao, migracao.Nome)
+			if err != nil {
+				return fmt.Errorf("aplicando a migração %d_%s: %w", migracao.Versao, migracao.Nome, err)
+			}
+			executadas = append(executadas, migracao)
+		}
+		return nil
+	})
+	return executadas, err
+}
+
+// Descer reverte a última migração aplicada, retorna nil se nenhuma migração foi aplicada
+func (m *Migrador) Descer(ctx context.Context) (*Migracao, error) {
+	var revertida *Migracao
+	err := m.comLock(ctx, func(conn *sql.Conn) error {
+		versoes, err := aplicadas(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migracoes) - 1; i >= 0; i-- {
+			migracao := m.migracoes[i]
+			if _, ok := versoes[migracao.Versao]; !ok {
+				continue
+			}
+			err := executarMigracao(ctx, conn, migracao.descer,
+				"DELETE FROM public.schema_migrations WHERE versao = $1", migracao.Versao)
+			if err != nil {
+				return fmt.Errorf("revertendo a migração %d_%s: %w", migracao.Versao, migracao.Nome, err)
+			}
+			revertida = &migracao
+			return nil
+		}
+		return nil
+	})
+	return revertida, err
+}
+
+// Status retorna todas as migrações embutidas com a data em que foram aplicadas
+func (m *Migrador) Status(ctx context.Context) ([]StatusMigracao, error) {
+	status := make([]StatusMigracao, 0, len(m.migracoes))
+	err := m.comLock(ctx, func(conn *sql.Conn) error {
+		versoes, err := aplicadas(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migracao := range m.migracoes {
+			s := StatusMigracao{Migracao: migracao}
+			if aplicadaEm, ok := versoes[migracao.Versao]; ok {
+				s.AplicadaEm = &aplicadaEm
+			}
+			status = append(status, s)
+		}
+		return nil
+	})
+	return status, err
+}
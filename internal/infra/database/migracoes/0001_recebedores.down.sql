@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS pagamento.recebedores;
+DROP TYPE IF EXISTS pagamento.tipo_chave_pix_enum;
+DROP SCHEMA IF EXISTS pagamento;
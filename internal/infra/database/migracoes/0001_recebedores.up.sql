@@ -0,0 +1,32 @@
+-- as migrações iniciais usam IF NOT EXISTS para adotar os bancos criados pelo antigo scripts/init.sql
+CREATE SCHEMA IF NOT EXISTS pagamento;
+
+DO $$
+BEGIN
+	CREATE TYPE pagamento.tipo_chave_pix_enum AS ENUM ('CPF', 'CNPJ', 'EMAIL', 'TELEFONE', 'CHAVE_ALEATORIA');
+EXCEPTION
+	WHEN duplicate_object THEN NULL;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS pagamento.recebedores (
+	recebedor_id SERIAL PRIMARY KEY,
+	cpf_cnpj VARCHAR(20) NOT NULL,
+	nome VARCHAR(100) NOT NULL,
+	tipo_chave_pix pagamento.tipo_chave_pix_enum NOT NULL,
+	chave_pix VARCHAR(140) NOT NULL,
+	status_recebedor VARCHAR(15) DEFAULT 'Rascunho',
+	email VARCHAR(250) DEFAULT NULL
+);
+
+-- as chaves do tipo telefone eram armazenadas sem o código do país (DDNNNNNNNNN), os recebedores dos
+-- bancos adotados são convertidos para o formato E.164 (+55DDNNNNNNNNN) utilizado nas buscas
+WITH telefones AS (
+	SELECT recebedor_id, regexp_replace(chave_pix, '[^0-9]', '', 'g') AS digitos
+	FROM pagamento.recebedores
+	WHERE tipo_chave_pix = 'TELEFONE' AND chave_pix !~ '^\+55[0-9]{10,11}$'
+)
+UPDATE pagamento.recebedores r
+SET chave_pix = CASE WHEN length(t.digitos) IN (10, 11) THEN '+55' || t.digitos ELSE '+' || t.digitos END
+FROM telefones t
+WHERE r.recebedor_id = t.recebedor_id AND (length(t.digitos) IN (10, 11) OR t.digitos ~ '^55[0-9]{10,11}$');
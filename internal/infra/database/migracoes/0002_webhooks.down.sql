@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS pagamento.webhook_entregas;
+DROP TABLE IF EXISTS pagamento.webhooks;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS pagamento.webhooks (
+	webhook_id SERIAL PRIMARY KEY,
+	url VARCHAR(2048) NOT NULL,
+	eventos TEXT[] NOT NULL DEFAULT '{}',
+	segredo VARCHAR(256) NOT NULL,
+	criado_em TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS pagamento.webhook_entregas (
+	entrega_id SERIAL PRIMARY KEY,
+	webhook_id INTEGER NOT NULL REFERENCES pagamento.webhooks (webhook_id) ON DELETE CASCADE,
+	evento_id VARCHAR(36) NOT NULL,
+	tipo_evento VARCHAR(50) NOT NULL,
+	payload JSONB NOT NULL,
+	status VARCHAR(15) NOT NULL DEFAULT 'Pendente',
+	tentativas INTEGER NOT NULL DEFAULT 0,
+	proxima_tentativa TIMESTAMPTZ NOT NULL DEFAULT now(),
+	ultimo_erro TEXT NOT NULL DEFAULT '',
+	criado_em TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS webhook_entregas_pendentes_idx ON pagamento.webhook_entregas (status, proxima_tentativa);
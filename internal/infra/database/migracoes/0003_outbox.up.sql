@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS pagamento.outbox (
+	outbox_id BIGSERIAL PRIMARY KEY,
+	evento_id VARCHAR(36) NOT NULL,
+	tipo_evento VARCHAR(50) NOT NULL,
+	recebedor_id INTEGER NOT NULL,
+	payload JSONB NOT NULL,
+	criado_em TIMESTAMPTZ NOT NULL DEFAULT now(),
+	publicado_em TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pendentes_idx ON pagamento.outbox (outbox_id) WHERE publicado_em IS NULL;
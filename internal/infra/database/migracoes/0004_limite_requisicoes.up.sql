@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS pagamento.limite_requisicoes (
+	chave VARCHAR(200) PRIMARY KEY,
+	tokens DOUBLE PRECISION NOT NULL,
+	permitido BOOLEAN NOT NULL,
+	atualizado_em TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS limite_requisicoes_atualizado_idx ON pagamento.limite_requisicoes (atualizado_em);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS recebedores_deletados ON pagamento.recebedores;
+DROP FUNCTION IF EXISTS pagamento.registrar_chave_deletada();
+DROP TABLE IF EXISTS pagamento.chaves_deletadas;
@@ -0,0 +1,30 @@
+-- as chaves dos recebedores deletados são mantidas para a detecção de recebedores suspeitos, o outbox
+-- é uma fila de entrega e pode ser expurgado
+CREATE TABLE IF NOT EXISTS pagamento.chaves_deletadas (
+	chave_pix VARCHAR(140) NOT NULL,
+	recebedor_id INTEGER NOT NULL,
+	cpf_cnpj VARCHAR(20) NOT NULL,
+	nome VARCHAR(100) NOT NULL,
+	deletado_em TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS chaves_deletadas_chave_idx ON pagamento.chaves_deletadas (chave_pix);
+
+-- as chaves deletadas antes da tabela são recuperadas dos eventos ainda mantidos no outbox
+INSERT INTO pagamento.chaves_deletadas (chave_pix, recebedor_id, cpf_cnpj, nome, deletado_em)
+SELECT payload->'recebedor'->>'chave_pix', recebedor_id, COALESCE(payload->'recebedor'->>'cpf_cnpj', ''),
+	COALESCE(payload->'recebedor'->>'nome', ''), criado_em
+FROM pagamento.outbox
+WHERE tipo_evento = 'recebedor.deletado' AND payload->'recebedor'->>'chave_pix' IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION pagamento.registrar_chave_deletada() RETURNS trigger AS $$
+BEGIN
+	INSERT INTO pagamento.chaves_deletadas (chave_pix, recebedor_id, cpf_cnpj, nome)
+	VALUES (OLD.chave_pix, OLD.recebedor_id, OLD.cpf_cnpj, OLD.nome);
+	RETURN OLD;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS recebedores_deletados ON pagamento.recebedores;
+CREATE TRIGGER recebedores_deletados AFTER DELETE ON pagamento.recebedores
+	FOR EACH ROW EXECUTE FUNCTION pagamento.registrar_chave_deletada();
@@ -0,0 +1,18 @@
+-- ocorrências da triagem de listas restritivas, mantidas mesmo após a deleção do recebedor
+CREATE TABLE IF NOT EXISTS pagamento.ocorrencias_triagem (
+	ocorrencia_id SERIAL PRIMARY KEY,
+	recebedor_id INTEGER NOT NULL,
+	lista VARCHAR(100) NOT NULL,
+	cpf_cnpj_lista VARCHAR(20) NOT NULL DEFAULT '',
+	nome_lista VARCHAR(250) NOT NULL DEFAULT '',
+	motivo TEXT NOT NULL DEFAULT '',
+	criterio VARCHAR(20) NOT NULL,
+	similaridade DOUBLE PRECISION NOT NULL,
+	status VARCHAR(15) NOT NULL DEFAULT 'Pendente',
+	observacao TEXT NOT NULL DEFAULT '',
+	criado_em TIMESTAMPTZ NOT NULL DEFAULT now(),
+	revisado_em TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS ocorrencias_triagem_recebedor_idx ON pagamento.ocorrencias_triagem (recebedor_id);
+CREATE INDEX IF NOT EXISTS ocorrencias_triagem_status_idx ON pagamento.ocorrencias_triagem (status);
@@ -0,0 +1 @@
+ALTER TABLE pagamento.recebedores DROP COLUMN IF EXISTS email_verificado_em;
@@ -0,0 +1,2 @@
+-- data da verificação do email pelo token enviado ao recebedor
+ALTER TABLE pagamento.recebedores ADD COLUMN IF NOT EXISTS email_verificado_em TIMESTAMPTZ NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS pagamento.webhook_entregas_evento_idx;
@@ -0,0 +1,6 @@
+-- o relay reenvia o evento quando algum publicador falha, a entrega de cada webhook é criada uma única vez
+DELETE FROM pagamento.webhook_entregas e
+USING pagamento.webhook_entregas d
+WHERE e.webhook_id = d.webhook_id AND e.evento_id = d.evento_id AND e.entrega_id > d.entrega_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS webhook_entregas_evento_idx ON pagamento.webhook_entregas (webhook_id, evento_id);
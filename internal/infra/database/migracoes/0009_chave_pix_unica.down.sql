@@ -0,0 +1 @@
+DROP INDEX IF EXISTS pagamento.recebedores_chave_pix_idx;
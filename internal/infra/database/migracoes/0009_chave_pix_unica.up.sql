@@ -0,0 +1,11 @@
+-- a verificação do serviço não impede que duas requisições simultâneas cadastrem a mesma chave,
+-- a migração falha se já existirem chaves duplicadas, que precisam ser corrigidas manualmente
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM pagamento.recebedores GROUP BY chave_pix HAVING count(*) > 1) THEN
+		RAISE EXCEPTION 'existem recebedores com a mesma chave pix, corrija as duplicidades antes de aplicar a migração';
+	END IF;
+END
+$$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS recebedores_chave_pix_idx ON pagamento.recebedores (chave_pix);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS pagamento.outbox_pendentes_idx;
+CREATE INDEX IF NOT EXISTS outbox_pendentes_idx ON pagamento.outbox (outbox_id) WHERE publicado_em IS NULL;
+
+ALTER TABLE pagamento.outbox DROP COLUMN IF EXISTS erro_descarte;
+ALTER TABLE pagamento.outbox DROP COLUMN IF EXISTS descartado_em;
+ALTER TABLE pagamento.outbox DROP COLUMN IF EXISTS reservado_ate;
@@ -0,0 +1,9 @@
+-- o relay reserva os eventos antes de publicá-los, fora da transação da consulta, a reserva expira
+-- para que os eventos de uma instância interrompida durante a publicação sejam publicados novamente
+ALTER TABLE pagamento.outbox ADD COLUMN IF NOT EXISTS reservado_ate TIMESTAMPTZ NULL;
+-- eventos com payload inválido são descartados com o erro, para não impedir a publicação dos seguintes
+ALTER TABLE pagamento.outbox ADD COLUMN IF NOT EXISTS descartado_em TIMESTAMPTZ NULL;
+ALTER TABLE pagamento.outbox ADD COLUMN IF NOT EXISTS erro_descarte TEXT NULL;
+
+DROP INDEX IF EXISTS pagamento.outbox_pendentes_idx;
+CREATE INDEX IF NOT EXISTS outbox_pendentes_idx ON pagamento.outbox (outbox_id) WHERE publicado_em IS NULL AND descartado_em IS NULL;
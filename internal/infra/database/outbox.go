@@ -0,0 +1,239 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// registra o evento do recebedor na tabela de outbox, deve ser chamado na mesma transação
+// da alteração do recebedor para que o evento só exista se a alteração for confirmada
+func registrarEvento(ctx context.Context, tx *sql.Tx, tipo domain.TipoEvento, recebedor *domain.Recebedor) error {
+	evento := domain.Evento{Id: uuid.NewString(), Tipo: tipo, OcorridoEm: time.Now().UTC(), Recebedor: recebedor}
+	payload, err := json.Marshal(evento)
+	if err != nil {
+		return err
+	}
+	query := "INSERT INTO pagamento.outbox (evento_id, tipo_evento, recebedor_id, payload, criado_em) VALUES ($1, $2, $3, $4, $5)"
+	_, err = tx.ExecContext(ctx, query, evento.Id, evento.Tipo, recebedor.Id, payload, evento.OcorridoEm)
+	return err
+}
+
+// executa a função em uma transação, confirmando a transação apenas se a função não retornar erro
+func executarEmTransacao(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+const (
+	// chave do advisory lock que elege a instância responsável por publicar o outbox
+	chaveLiderOutbox = 7251032
+
+	tarefaOutbox = "outbox" //label das métricas de erros do relay
+
+	intervaloRelayPadrao = 2 * time.Second
+	loteRelayPadrao      = 100
+	reservaRelayPadrao   = time.Minute
+)
+
+// Relay publica os eventos registrados no outbox. Apenas a instância que obtém o advisory lock
+// publica os eventos, garantindo a ordem de publicação mesmo com várias instâncias da aplicação.
+// Os eventos são publicados ao menos uma vez: uma falha após a publicação e antes da confirmação
+// faz o evento ser publicado novamente quando a reserva do evento expirar
+type Relay struct {
+	db         *sql.DB
+	publicador domain.PublicadorEventos
+	logger     *zap.Logger
+	intervalo  time.Duration
+	lote       int
+	reserva    time.Duration
+}
+
+func NewRelay(db *sql.DB, publicador domain.PublicadorEventos, logger *zap.Logger) *Relay {
+	return &Relay{
+		db:         db,
+		publicador: publicador,
+		logger:     logger,
+		intervalo:  intervaloRelayPadrao,
+		lote:       loteRelayPadrao,
+		reserva:    reservaRelayPadrao,
+	}
+}
+
+// disputa a liderança e publica os eventos periodicamente até o contexto ser cancelado
+func (r *Relay) Executar(ctx context.Context) {
+	ticker := time.NewTicker(r.intervalo)
+	defer ticker.Stop()
+	for {
+		if err := r.liderar(ctx, ticker.C); err != nil && ctx.Err() == nil {
+			r.logger.Error("executando relay do outbox", zap.Error(err))
+			metricas.RegistrarErroTarefa(tarefaOutbox, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// tenta obter o advisory lock em uma conexão dedicada, o lock pertence à sessão e é liberado
+// quando a conexão é encerrada, permitindo que outra instância assuma a publicação
+func (r *Relay) liderar(ctx context.Context, tick <-chan time.Time) error {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	var lider bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", chaveLiderOutbox).Scan(&lider); err != nil {
+		return err
+	}
+	if !lider {
+		return nil
+	}
+	r.logger.Info("instância eleita para publicar o outbox")
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", chaveLiderOutbox)
+	for {
+		//a conexão do lock precisa continuar ativa para manter a liderança
+		if err := conn.PingContext(ctx); err != nil {
+			return err
+		}
+		for {
+			processados, err := r.publicarLote(ctx)
+			if err != nil {
+				r.logger.Error("publicando eventos do outbox", zap.Error(err))
+				metricas.RegistrarErroTarefa(tarefaOutbox, err)
+				break
+			}
+			if processados < r.lote {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick:
+		}
+	}
+}
+
+// evento reservado para publicação
+type eventoOutbox struct {
+	id     int64
+	evento domain.Evento
+}
+
+func idsEventos(eventos []eventoOutbox) []int64 {
+	ids := make([]int64, len(eventos))
+	for i, evento := range eventos {
+		ids[i] = evento.id
+	}
+	return ids
+}
+
+// payload do outbox que não pôde ser lido e foi descartado
+type eventoDescartado struct {
+	id  int64
+	err error
+}
+
+// reserva em ordem um lote de eventos pendentes, confirmando a reserva antes da publicação para que
+// as linhas não fiquem bloqueadas enquanto o publicador é chamado. Os eventos com payload inválido
+// são descartados, pois nunca poderiam ser publicados e impediriam a publicação dos seguintes
+func (r *Relay) reservarLote(ctx context.Context) ([]eventoOutbox, []eventoDescartado, error) {
+	var reservados []eventoOutbox
+	var descartados []eventoDescartado
+	err := executarEmTransacao(ctx, r.db, func(tx *sql.Tx) error {
+		reservados, descartados = nil, nil
+		query := "SELECT outbox_id, payload FROM pagamento.outbox WHERE publicado_em IS NULL AND descartado_em IS NULL " +
+			"AND (reservado_ate IS NULL OR reservado_ate < now()) ORDER BY outbox_id LIMIT $1 FOR UPDATE SKIP LOCKED"
+		rows, err := tx.QueryContext(ctx, query, r.lote)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var id int64
+			var payload []byte
+			var evento domain.Evento
+			if err := rows.Scan(&id, &payload); err != nil {
+				rows.Close()
+				return err
+			}
+			if err := json.Unmarshal(payload, &evento); err != nil {
+				descartados = append(descartados, eventoDescartado{id, err})
+				continue
+			}
+			reservados = append(reservados, eventoOutbox{id, evento})
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, descartado := range descartados {
+			query := "UPDATE pagamento.outbox SET descartado_em = now(), erro_descarte = $1 WHERE outbox_id = $2"
+			if _, err := tx.ExecContext(ctx, query, descartado.err.Error(), descartado.id); err != nil {
+				return err
+			}
+		}
+		if len(reservados) > 0 {
+			query := "UPDATE pagamento.outbox SET reservado_ate = now() + $1 * interval '1 second' WHERE outbox_id = ANY($2)"
+			if _, err := tx.ExecContext(ctx, query, r.reserva.Seconds(), pq.Array(idsEventos(reservados))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reservados, descartados, nil
+}
+
+// publica em ordem um lote de eventos pendentes, interrompendo na primeira falha para que
+// os eventos seguintes não sejam publicados antes dele. Retorna a quantidade de eventos processados,
+// publicados ou descartados
+func (r *Relay) publicarLote(ctx context.Context) (int, error) {
+	reservados, descartados, err := r.reservarLote(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, descartado := range descartados {
+		r.logger.Error("evento do outbox descartado", zap.Int64("outbox_id", descartado.id), zap.Error(descartado.err))
+		metricas.RegistrarErroTarefa(tarefaOutbox, descartado.err)
+	}
+
+	publicados := 0
+	var errPublicacao error
+	for _, reservado := range reservados {
+		if errPublicacao = r.publicador.Publicar(ctx, reservado.evento); errPublicacao != nil {
+			break
+		}
+		publicados++
+	}
+	ids := idsEventos(reservados)
+	//a reserva dos eventos não publicados é liberada para que sejam publicados novamente em ordem,
+	//se a confirmação falhar os eventos são publicados novamente quando a reserva expirar
+	query := "UPDATE pagamento.outbox SET publicado_em = CASE WHEN outbox_id = ANY($1) THEN now() END, reservado_ate = NULL WHERE outbox_id = ANY($2)"
+	if len(ids) > 0 {
+		if _, err := r.db.ExecContext(ctx, query, pq.Array(ids[:publicados]), pq.Array(ids)); err != nil {
+			return 0, err
+		}
+	}
+	return publicados + len(descartados), errPublicacao
+}
@@ -1,36 +1,167 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/lib/pq"
+)
+
+const (
+	timeoutConsultaPadrao = 5 * time.Second
+	timeoutEscritaPadrao  = 10 * time.Second
 )
 
 type postgresRecebedorRepository struct {
-	DB *sql.DB
+	DB              *sql.DB
+	timeoutConsulta time.Duration
+	timeoutEscrita  time.Duration
 }
 
-func NewPostgresRecebedorRepository(db *sql.DB) *postgresRecebedorRepository {
-	return &postgresRecebedorRepository{DB: db}
+// configuração opcional do repositório
+type OpcaoRepository func(*postgresRecebedorRepository)
+
+// define o tempo máximo de cada consulta e de cada operação de escrita
+func ComTimeouts(consulta, escrita time.Duration) OpcaoRepository {
+	return func(r *postgresRecebedorRepository) {
+		r.timeoutConsulta = consulta
+		r.timeoutEscrita = escrita
+	}
 }
 
-func (r *postgresRecebedorRepository) CriarRecebedor(recebedor *domain.Recebedor) error {
-	query := "INSERT INTO pagamento.recebedores (cpf_cnpj, nome, tipo_chave_pix,chave_pix, status_recebedor, email) VALUES ($1, $2, $3,$4, $5,$6) RETURNING recebedor_id"
+func NewPostgresRecebedorRepository(db *sql.DB, opcoes ...OpcaoRepository) *postgresRecebedorRepository {
+	r := &postgresRecebedorRepository{DB: db, timeoutConsulta: timeoutConsultaPadrao, timeoutEscrita: timeoutEscritaPadrao}
+	for _, opcao := range opcoes {
+		opcao(r)
+	}
+	return r
+}
 
-	err := r.DB.QueryRow(query, recebedor.CpfCnpj, recebedor.Nome, recebedor.TipoChavePix, recebedor.ChavePix, recebedor.Status, recebedor.Email).Scan(&recebedor.Id)
-	if err != nil {
-		return err
+// limita o contexto da operação, o cancelamento da requisição também interrompe a query no postgres
+func (r *postgresRecebedorRepository) consulta(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, r.timeoutConsulta)
+}
+
+func (r *postgresRecebedorRepository) escrita(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, r.timeoutEscrita)
+}
+
+// o driver retorna o erro de cancelamento do postgres, o erro do contexto
+// é retornado para que o timeout seja identificado pelas outras camadas
+func erroContexto(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
 	}
+	return err
+}
 
-	return nil
+// código do postgres para a violação de uma restrição de unicidade
+const violacaoUnicidade = "23505"
+
+// traduz a violação do índice único da chave pix para o erro do domínio, a verificação do
+// serviço não impede que duas requisições simultâneas cadastrem a mesma chave
+func traduzirErro(err error) error {
+	var erroPq *pq.Error
+	if errors.As(err, &erroPq) && erroPq.Code == violacaoUnicidade && erroPq.Constraint == "recebedores_chave_pix_idx" {
+		return domain.ErrChavePixJaCadastrada
+	}
+	return err
 }
 
-func (r *postgresRecebedorRepository) BuscarRecebedorPorId(id uint) (*domain.Recebedor, error) {
-	query := "SELECT recebedor_id,cpf_cnpj, nome, tipo_chave_pix, chave_pix, status_recebedor, email FROM pagamento.recebedores WHERE recebedor_id = $1"
+const colunasRecebedor = "recebedor_id,cpf_cnpj, nome, tipo_chave_pix, chave_pix, status_recebedor, email, email_verificado_em"
 
+func (r *postgresRecebedorRepository) CriarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	query := "INSERT INTO pagamento.recebedores (cpf_cnpj, nome, tipo_chave_pix,chave_pix, status_recebedor, email) VALUES ($1, $2, $3,$4, $5,$6) RETURNING recebedor_id"
+	ctx, cancel := r.escrita(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "CriarRecebedor", query)
+	err := executarEmTransacao(ctx, r.DB, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, query, recebedor.CpfCnpj, recebedor.Nome, recebedor.TipoChavePix, recebedor.ChavePix, recebedor.Status, recebedor.Email).Scan(&recebedor.Id)
+		if err != nil {
+			return err
+		}
+		return registrarEvento(ctx, tx, domain.EventoRecebedorCriado, recebedor)
+	})
+	err = traduzirErro(erroContexto(ctx, err))
+	finalizarSpan(span, err)
+	return err
+}
+
+// executa a query de alteração que retorna as colunas do recebedor e registra o evento
+// com o recebedor alterado, nenhum evento é registrado se nenhum recebedor foi alterado
+func (r *postgresRecebedorRepository) alterarComEvento(ctx context.Context, operacao string, tipo domain.TipoEvento, query string, args ...interface{}) error {
+	_, err := r.alterarComEventos(ctx, operacao, tipo, query, args...)
+	return err
+}
+
+// assim como alterarComEvento, retornando os recebedores alterados
+func (r *postgresRecebedorRepository) alterarComEventos(ctx context.Context, operacao string, tipo domain.TipoEvento, query string, args ...interface{}) ([]*domain.Recebedor, error) {
+	ctx, cancel := r.escrita(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, operacao, query)
+	var recebedores []*domain.Recebedor
+	err := executarEmTransacao(ctx, r.DB, func(tx *sql.Tx) error {
+		//as linhas precisam ser lidas e fechadas antes de registrar os eventos na mesma transação
+		var err error
+		recebedores, err = scanRecebedores(tx.QueryContext(ctx, query, args...))
+		if err != nil {
+			return err
+		}
+		for _, recebedor := range recebedores {
+			if err := registrarEvento(ctx, tx, tipo, recebedor); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	err = traduzirErro(erroContexto(ctx, err))
+	finalizarSpan(span, err)
+	return recebedores, err
+}
+
+// executa a consulta que retorna as colunas do recebedor
+func (r *postgresRecebedorRepository) consultarRecebedores(ctx context.Context, operacao, query string, args ...interface{}) ([]*domain.Recebedor, error) {
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, operacao, query)
+	recebedores, err := scanRecebedores(r.DB.QueryContext(ctx, query, args...))
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
+	return recebedores, err
+}
+
+func scanRecebedores(rows *sql.Rows, err error) ([]*domain.Recebedor, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recebedores := []*domain.Recebedor{}
+	for rows.Next() {
+		var recebedor domain.Recebedor
+		if err := rows.Scan(&recebedor.Id, &recebedor.CpfCnpj, &recebedor.Nome, &recebedor.TipoChavePix, &recebedor.ChavePix, &recebedor.Status, &recebedor.Email, &recebedor.EmailVerificadoEm); err != nil {
+			return nil, err
+		}
+		recebedores = append(recebedores, &recebedor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return recebedores, nil
+}
+
+func (r *postgresRecebedorRepository) BuscarRecebedorPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	query := "SELECT " + colunasRecebedor + " FROM pagamento.recebedores WHERE recebedor_id = $1"
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "BuscarRecebedorPorId", query)
 	var recebedor domain.Recebedor
-	err := r.DB.QueryRow(query, id).Scan(&recebedor.Id, &recebedor.CpfCnpj, &recebedor.Nome, &recebedor.TipoChavePix, &recebedor.ChavePix, &recebedor.Status, &recebedor.Email)
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&recebedor.Id, &recebedor.CpfCnpj, &recebedor.Nome, &recebedor.TipoChavePix, &recebedor.ChavePix, &recebedor.Status, &recebedor.Email, &recebedor.EmailVerificadoEm)
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -40,11 +171,15 @@ func (r *postgresRecebedorRepository) BuscarRecebedorPorId(id uint) (*domain.Rec
 	return &recebedor, nil
 }
 
-func (r *postgresRecebedorRepository) BuscarChave(chave string) (string, error) {
+func (r *postgresRecebedorRepository) BuscarChave(ctx context.Context, chave string) (string, error) {
 	query := "SELECT chave_pix FROM pagamento.recebedores WHERE chave_pix = $1"
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "BuscarChave", query)
 	var result string
-
-	err := r.DB.QueryRow(query, chave).Scan(&result)
+	err := r.DB.QueryRowContext(ctx, query, chave).Scan(&result)
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return "", nil
@@ -54,34 +189,35 @@ func (r *postgresRecebedorRepository) BuscarChave(chave string) (string, error)
 	return result, nil
 }
 
-func (r *postgresRecebedorRepository) ContarRecebedoresPorCampo(valor, nomeCampo string) (int, error) {
+func (r *postgresRecebedorRepository) ContarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string) (int, error) {
 	query := fmt.Sprintf("SELECT COUNT(recebedor_id) FROM pagamento.recebedores WHERE %s = $1", nomeCampo)
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "ContarRecebedoresPorCampo", query)
 	var totalRegistros int
-	err := r.DB.QueryRow(query, valor).Scan(&totalRegistros)
+	err := r.DB.QueryRowContext(ctx, query, valor).Scan(&totalRegistros)
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
 	if err != nil {
 		return 0, err
 	}
 	return totalRegistros, nil
 }
-func (r *postgresRecebedorRepository) DeletarRecebedor(id uint) error {
-	query := "DELETE FROM pagamento.recebedores WHERE recebedor_id = $1"
-	_, err := r.DB.Exec(query, id)
-	if err != nil {
-		return err
-	}
-
-	return nil
+func (r *postgresRecebedorRepository) DeletarRecebedor(ctx context.Context, id uint) error {
+	query := "DELETE FROM pagamento.recebedores WHERE recebedor_id = $1 RETURNING " + colunasRecebedor
+	return r.alterarComEvento(ctx, "DeletarRecebedor", domain.EventoRecebedorDeletado, query, id)
 }
 
 // Deprecated: não utilizar
-func (r *postgresRecebedorRepository) DeletarRecebedores(ids []uint) error {
-
-	tx, err := r.DB.Begin()
+func (r *postgresRecebedorRepository) DeletarRecebedores(ctx context.Context, ids []uint) error {
+	ctx, cancel := r.escrita(ctx)
+	defer cancel()
+	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	query := "DELETE FROM pagamento.recebedores WHERE recebedor_id = $1"
-	stmt, err := tx.Prepare(query)
+	query := "DELETE FROM pagamento.recebedores WHERE recebedor_id = $1 RETURNING " + colunasRecebedor
+	stmt, err := tx.PrepareContext(ctx, query)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -89,11 +225,19 @@ func (r *postgresRecebedorRepository) DeletarRecebedores(ids []uint) error {
 	defer stmt.Close()
 
 	for _, id := range ids {
-		_, err := stmt.Exec(id)
+		var recebedor domain.Recebedor
+		err := stmt.QueryRowContext(ctx, id).Scan(&recebedor.Id, &recebedor.CpfCnpj, &recebedor.Nome, &recebedor.TipoChavePix, &recebedor.ChavePix, &recebedor.Status, &recebedor.Email, &recebedor.EmailVerificadoEm)
+		if err == sql.ErrNoRows {
+			continue
+		}
 		if err != nil {
 			tx.Rollback()
 			return err
 		}
+		if err := registrarEvento(ctx, tx, domain.EventoRecebedorDeletado, &recebedor); err != nil {
+			tx.Rollback()
+			return err
+		}
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -103,28 +247,62 @@ func (r *postgresRecebedorRepository) DeletarRecebedores(ids []uint) error {
 	return nil
 }
 
-func (r *postgresRecebedorRepository) BuscarRecebedoresPorCampo(valor, nomeCampo string, offset int) ([]*domain.Recebedor, error) {
-	query := fmt.Sprintf("SELECT recebedor_id,cpf_cnpj, nome, tipo_chave_pix, chave_pix, status_recebedor, email FROM pagamento.recebedores WHERE %s = $1 LIMIT 10 OFFSET $2", nomeCampo)
-	rows, err := r.DB.Query(query, valor, offset)
+func (r *postgresRecebedorRepository) BuscarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string, limite, offset int) ([]*domain.Recebedor, error) {
+	query := fmt.Sprintf("SELECT "+colunasRecebedor+" FROM pagamento.recebedores WHERE %s = $1 ORDER BY recebedor_id LIMIT $2 OFFSET $3", nomeCampo)
+	return r.consultarRecebedores(ctx, "BuscarRecebedoresPorCampo", query, valor, limite, offset)
+}
+
+func (r *postgresRecebedorRepository) ContarRecebedoresAgrupados(ctx context.Context) ([]domain.ContagemRecebedores, error) {
+	query := "SELECT status_recebedor, tipo_chave_pix, COUNT(recebedor_id) FROM pagamento.recebedores GROUP BY status_recebedor, tipo_chave_pix"
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "ContarRecebedoresAgrupados", query)
+	contagens, err := scanContagens(r.DB.QueryContext(ctx, query))
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
+	return contagens, err
+}
+
+func scanContagens(rows *sql.Rows, err error) ([]domain.ContagemRecebedores, error) {
 	if err != nil {
 		return nil, err
 	}
-	recebedores := []*domain.Recebedor{}
+	defer rows.Close()
+	contagens := []domain.ContagemRecebedores{}
 	for rows.Next() {
-		var recebedor domain.Recebedor
-		if err := rows.Scan(&recebedor.Id, &recebedor.CpfCnpj, &recebedor.Nome, &recebedor.TipoChavePix, &recebedor.ChavePix, &recebedor.Status, &recebedor.Email); err != nil {
+		var contagem domain.ContagemRecebedores
+		if err := rows.Scan(&contagem.Status, &contagem.TipoChavePix, &contagem.Total); err != nil {
 			return nil, err
 		}
-		recebedores = append(recebedores, &recebedor)
+		contagens = append(contagens, contagem)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-	return recebedores, nil
+	return contagens, nil
 }
 
-func (r *postgresRecebedorRepository) EditarRecebedor(recebedor *domain.Recebedor) error {
+func (r *postgresRecebedorRepository) ContarRecebedoresPorChaves(ctx context.Context, chaves []string) (int, error) {
+	query := "SELECT COUNT(recebedor_id) FROM pagamento.recebedores WHERE chave_pix = ANY($1)"
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "ContarRecebedoresPorChaves", query)
+	var totalRegistros int
+	err := r.DB.QueryRowContext(ctx, query, pq.Array(chaves)).Scan(&totalRegistros)
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
+	if err != nil {
+		return 0, err
+	}
+	return totalRegistros, nil
+}
+
+func (r *postgresRecebedorRepository) BuscarRecebedoresPorChaves(ctx context.Context, chaves []string, limite, offset int) ([]*domain.Recebedor, error) {
+	query := "SELECT " + colunasRecebedor + " FROM pagamento.recebedores WHERE chave_pix = ANY($1) ORDER BY recebedor_id LIMIT $2 OFFSET $3"
+	return r.consultarRecebedores(ctx, "BuscarRecebedoresPorChaves", query, pq.Array(chaves), limite, offset)
+}
+
+func (r *postgresRecebedorRepository) EditarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
 	query := "UPDATE pagamento.recebedores SET "
 	values := []interface{}{}
 	index := 1
@@ -149,7 +327,7 @@ func (r *postgresRecebedorRepository) EditarRecebedor(recebedor *domain.Recebedo
 		index++
 	}
 	if recebedor.Email != "" {
-		query += fmt.Sprintf("email = $%d, ", index)
+		query += fmt.Sprintf("%s, email = $%d, ", fmt.Sprintf(resetarVerificacao, index), index)
 		values = append(values, recebedor.Email)
 		index++
 	}
@@ -157,21 +335,61 @@ func (r *postgresRecebedorRepository) EditarRecebedor(recebedor *domain.Recebedo
 	query = query[:len(query)-2]
 	query += " WHERE recebedor_id = $"
 	query += fmt.Sprintf("%d", index)
+	query += " RETURNING " + colunasRecebedor
 	values = append(values, recebedor.Id)
-	fmt.Println(query)
-	fmt.Println(values...)
-	_, err := r.DB.Exec(query, values...)
-	if err != nil {
-		return err
+	return r.alterarComEvento(ctx, "EditarRecebedor", domain.EventoRecebedorEditado, query, values...)
+}
+
+// a verificação é removida se o email for alterado, as expressões do SET usam os valores anteriores à alteração
+const resetarVerificacao = "email_verificado_em = CASE WHEN email = $%d THEN email_verificado_em END"
+
+func (r *postgresRecebedorRepository) EditarEmailRecebedor(ctx context.Context, id uint, email string) error {
+	query := "UPDATE pagamento.recebedores SET " + fmt.Sprintf(resetarVerificacao, 1) + ", email = $1 WHERE recebedor_id = $2 RETURNING " + colunasRecebedor
+	return r.alterarComEvento(ctx, "EditarEmailRecebedor", domain.EventoRecebedorEditado, query, email, id)
+}
+
+func (r *postgresRecebedorRepository) EditarStatusRecebedor(ctx context.Context, id uint, status string) error {
+	query := "UPDATE pagamento.recebedores SET status_recebedor = $1 WHERE recebedor_id = $2 RETURNING " + colunasRecebedor
+	tipo := domain.EventoRecebedorEditado
+	switch status {
+	case domain.StatusValidado:
+		tipo = domain.EventoRecebedorValidado
+	case domain.StatusBloqueado:
+		tipo = domain.EventoRecebedorBloqueado
 	}
-	return nil
+	return r.alterarComEvento(ctx, "EditarStatusRecebedor", tipo, query, status, id)
 }
 
-func (r *postgresRecebedorRepository) EditarEmailRecebedor(id uint, email string) error {
-	query := "UPDATE pagamento.recebedores SET email = $1 WHERE recebedor_id = $2"
-	_, err := r.DB.Exec(query, email, id)
+func (r *postgresRecebedorRepository) VerificarEmailRecebedor(ctx context.Context, id uint, email string, verificadoEm time.Time) (bool, error) {
+	query := "UPDATE pagamento.recebedores SET email_verificado_em = $1 WHERE recebedor_id = $2 AND email = $3 AND email_verificado_em IS NULL RETURNING " + colunasRecebedor
+	recebedores, err := r.alterarComEventos(ctx, "VerificarEmailRecebedor", domain.EventoRecebedorEditado, query, verificadoEm, id, email)
+	return len(recebedores) > 0, err
+}
+
+// as chaves deletadas são registradas por gatilho na tabela chaves_deletadas, assim como no SQLite
+func (r *postgresRecebedorRepository) BuscarChavesDeletadas(ctx context.Context) ([]domain.ChaveDeletada, error) {
+	query := "SELECT chave_pix, recebedor_id, cpf_cnpj, nome, deletado_em FROM pagamento.chaves_deletadas ORDER BY deletado_em"
+	ctx, cancel := r.consulta(ctx)
+	defer cancel()
+	ctx, span := iniciarSpan(ctx, "BuscarChavesDeletadas", query)
+	deletadas, err := scanChavesDeletadas(r.DB.QueryContext(ctx, query))
+	err = erroContexto(ctx, err)
+	finalizarSpan(span, err)
+	return deletadas, err
+}
+
+func scanChavesDeletadas(rows *sql.Rows, err error) ([]domain.ChaveDeletada, error) {
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	defer rows.Close()
+	deletadas := []domain.ChaveDeletada{}
+	for rows.Next() {
+		var deletada domain.ChaveDeletada
+		if err := rows.Scan(&deletada.ChavePix, &deletada.RecebedorId, &deletada.CpfCnpj, &deletada.Nome, &deletada.DeletadoEm); err != nil {
+			return nil, err
+		}
+		deletadas = append(deletadas, deletada)
+	}
+	return deletadas, rows.Err()
 }
@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+type postgresOcorrenciaRepository struct {
+	DB *sql.DB
+}
+
+func NewPostgresOcorrenciaRepository(db *sql.DB) *postgresOcorrenciaRepository {
+	return &postgresOcorrenciaRepository{DB: db}
+}
+
+const colunasOcorrencia = "ocorrencia_id, recebedor_id, lista, cpf_cnpj_lista, nome_lista, motivo, criterio, similaridade, status, observacao, criado_em, revisado_em"
+
+func (r *postgresOcorrenciaRepository) CriarOcorrencia(ctx context.Context, ocorrencia *domain.OcorrenciaTriagem) error {
+	if ocorrencia.CriadoEm.IsZero() {
+		ocorrencia.CriadoEm = time.Now().UTC()
+	}
+	query := "INSERT INTO pagamento.ocorrencias_triagem (recebedor_id, lista, cpf_cnpj_lista, nome_lista, motivo, criterio, similaridade, status, observacao, criado_em) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING ocorrencia_id"
+	ctx, span := iniciarSpan(ctx, "CriarOcorrencia", query)
+	err := r.DB.QueryRowContext(ctx, query, ocorrencia.RecebedorId, ocorrencia.Lista, ocorrencia.Entrada.CpfCnpj, ocorrencia.Entrada.Nome, ocorrencia.Entrada.Motivo,
+		ocorrencia.Criterio, ocorrencia.Similaridade, ocorrencia.Status, ocorrencia.Observacao, ocorrencia.CriadoEm).Scan(&ocorrencia.Id)
+	finalizarSpan(span, err)
+	return err
+}
+
+func (r *postgresOcorrenciaRepository) buscarOcorrencias(ctx context.Context, operacao, query string, args ...interface{}) ([]*domain.OcorrenciaTriagem, error) {
+	ctx, span := iniciarSpan(ctx, operacao, query)
+	ocorrencias, err := scanOcorrencias(r.DB.QueryContext(ctx, query, args...))
+	finalizarSpan(span, err)
+	return ocorrencias, err
+}
+
+func scanOcorrencias(rows *sql.Rows, err error) ([]*domain.OcorrenciaTriagem, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ocorrencias := []*domain.OcorrenciaTriagem{}
+	for rows.Next() {
+		var ocorrencia domain.OcorrenciaTriagem
+		var revisadoEm sql.NullTime
+		if err := rows.Scan(&ocorrencia.Id, &ocorrencia.RecebedorId, &ocorrencia.Lista, &ocorrencia.Entrada.CpfCnpj, &ocorrencia.Entrada.Nome, &ocorrencia.Entrada.Motivo,
+			&ocorrencia.Criterio, &ocorrencia.Similaridade, &ocorrencia.Status, &ocorrencia.Observacao, &ocorrencia.CriadoEm, &revisadoEm); err != nil {
+			return nil, err
+		}
+		if revisadoEm.Valid {
+			ocorrencia.RevisadoEm = &revisadoEm.Time
+		}
+		ocorrencias = append(ocorrencias, &ocorrencia)
+	}
+	return ocorrencias, rows.Err()
+}
+
+func (r *postgresOcorrenciaRepository) BuscarOcorrenciaPorId(ctx context.Context, id uint) (*domain.OcorrenciaTriagem, error) {
+	query := "SELECT " + colunasOcorrencia + " FROM pagamento.ocorrencias_triagem WHERE ocorrencia_id = $1"
+	ocorrencias, err := r.buscarOcorrencias(ctx, "BuscarOcorrenciaPorId", query, id)
+	if err != nil || len(ocorrencias) == 0 {
+		return nil, err
+	}
+	return ocorrencias[0], nil
+}
+
+func (r *postgresOcorrenciaRepository) ListarOcorrencias(ctx context.Context, status domain.StatusOcorrencia) ([]*domain.OcorrenciaTriagem, error) {
+	query := "SELECT " + colunasOcorrencia + " FROM pagamento.ocorrencias_triagem WHERE $1 = '' OR status = $1 ORDER BY ocorrencia_id"
+	return r.buscarOcorrencias(ctx, "ListarOcorrencias", query, string(status))
+}
+
+func (r *postgresOcorrenciaRepository) BuscarOcorrenciasPorRecebedor(ctx context.Context, recebedorId uint) ([]*domain.OcorrenciaTriagem, error) {
+	query := "SELECT " + colunasOcorrencia + " FROM pagamento.ocorrencias_triagem WHERE recebedor_id = $1 ORDER BY ocorrencia_id"
+	return r.buscarOcorrencias(ctx, "BuscarOcorrenciasPorRecebedor", query, recebedorId)
+}
+
+func (r *postgresOcorrenciaRepository) RevisarOcorrencia(ctx context.Context, ocorrencia *domain.OcorrenciaTriagem) error {
+	query := "UPDATE pagamento.ocorrencias_triagem SET status = $1, observacao = $2, revisado_em = $3 WHERE ocorrencia_id = $4"
+	ctx, span := iniciarSpan(ctx, "RevisarOcorrencia", query)
+	_, err := r.DB.ExecContext(ctx, query, ocorrencia.Status, ocorrencia.Observacao, ocorrencia.RevisadoEm, ocorrencia.Id)
+	finalizarSpan(span, err)
+	return err
+}
@@ -0,0 +1,182 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/lib/pq"
+)
+
+type postgresWebhookRepository struct {
+	DB *sql.DB
+}
+
+func NewPostgresWebhookRepository(db *sql.DB) *postgresWebhookRepository {
+	return &postgresWebhookRepository{DB: db}
+}
+
+const colunasEntrega = "entrega_id, webhook_id, payload, status, tentativas, proxima_tentativa, ultimo_erro, criado_em"
+
+func (r *postgresWebhookRepository) CriarWebhook(ctx context.Context, webhook *domain.Webhook) error {
+	query := "INSERT INTO pagamento.webhooks (url, eventos, segredo) VALUES ($1, $2, $3) RETURNING webhook_id, criado_em"
+	eventos := make([]string, len(webhook.Eventos))
+	for i, evento := range webhook.Eventos {
+		eventos[i] = string(evento)
+	}
+	return r.DB.QueryRowContext(ctx, query, webhook.Url, pq.Array(eventos), webhook.Segredo).Scan(&webhook.Id, &webhook.CriadoEm)
+}
+
+func (r *postgresWebhookRepository) BuscarWebhookPorId(ctx context.Context, id uint) (*domain.Webhook, error) {
+	query := "SELECT webhook_id, url, eventos, segredo, criado_em FROM pagamento.webhooks WHERE webhook_id = $1"
+	webhook, err := scanWebhook(r.DB.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (r *postgresWebhookRepository) ListarWebhooks(ctx context.Context) ([]*domain.Webhook, error) {
+	query := "SELECT webhook_id, url, eventos, segredo, criado_em FROM pagamento.webhooks ORDER BY webhook_id"
+	return r.buscarWebhooks(ctx, query)
+}
+
+func (r *postgresWebhookRepository) BuscarWebhooksPorEvento(ctx context.Context, tipo domain.TipoEvento) ([]*domain.Webhook, error) {
+	//webhooks sem eventos informados recebem todos os eventos
+	query := "SELECT webhook_id, url, eventos, segredo, criado_em FROM pagamento.webhooks WHERE cardinality(eventos) = 0 OR $1 = ANY(eventos) ORDER BY webhook_id"
+	return r.buscarWebhooks(ctx, query, tipo)
+}
+
+func (r *postgresWebhookRepository) DeletarWebhook(ctx context.Context, id uint) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM pagamento.webhooks WHERE webhook_id = $1", id)
+	return err
+}
+
+func (r *postgresWebhookRepository) CriarEntrega(ctx context.Context, entrega *domain.EntregaWebhook) error {
+	payload, err := json.Marshal(entrega.Evento)
+	if err != nil {
+		return err
+	}
+	//a entrega já criada para o webhook e o evento não é alterada, o insert não retorna linhas
+	query := `INSERT INTO pagamento.webhook_entregas (webhook_id, evento_id, tipo_evento, payload, status, proxima_tentativa) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (webhook_id, evento_id) DO NOTHING RETURNING entrega_id, criado_em`
+	err = r.DB.QueryRowContext(ctx, query, entrega.WebhookId, entrega.Evento.Id, entrega.Evento.Tipo, payload, entrega.Status, entrega.ProximaTentativa).Scan(&entrega.Id, &entrega.CriadoEm)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	return err
+}
+
+func (r *postgresWebhookRepository) BuscarEntregaPorId(ctx context.Context, id uint) (*domain.EntregaWebhook, error) {
+	query := fmt.Sprintf("SELECT %s FROM pagamento.webhook_entregas WHERE entrega_id = $1", colunasEntrega)
+	entrega, err := scanEntrega(r.DB.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entrega, nil
+}
+
+func (r *postgresWebhookRepository) ReservarEntregasPendentes(ctx context.Context, limite int, reserva time.Duration) ([]*domain.EntregaWebhook, error) {
+	// SKIP LOCKED garante que instâncias concorrentes não reservem a mesma entrega
+	query := fmt.Sprintf(`UPDATE pagamento.webhook_entregas SET proxima_tentativa = now() + $2 * interval '1 millisecond'
+		WHERE entrega_id IN (
+			SELECT entrega_id FROM pagamento.webhook_entregas
+			WHERE status = $3 AND proxima_tentativa <= now()
+			ORDER BY proxima_tentativa LIMIT $1 FOR UPDATE SKIP LOCKED)
+		RETURNING %s`, colunasEntrega)
+	rows, err := r.DB.QueryContext(ctx, query, limite, reserva.Milliseconds(), domain.EntregaPendente)
+	if err != nil {
+		return nil, err
+	}
+	return scanEntregas(rows)
+}
+
+func (r *postgresWebhookRepository) AtualizarEntrega(ctx context.Context, entrega *domain.EntregaWebhook) error {
+	query := "UPDATE pagamento.webhook_entregas SET status = $1, tentativas = $2, proxima_tentativa = $3, ultimo_erro = $4 WHERE entrega_id = $5"
+	_, err := r.DB.ExecContext(ctx, query, entrega.Status, entrega.Tentativas, entrega.ProximaTentativa, entrega.UltimoErro, entrega.Id)
+	return err
+}
+
+func (r *postgresWebhookRepository) ListarEntregasComFalha(ctx context.Context) ([]*domain.EntregaWebhook, error) {
+	query := fmt.Sprintf("SELECT %s FROM pagamento.webhook_entregas WHERE status = $1 ORDER BY entrega_id", colunasEntrega)
+	rows, err := r.DB.QueryContext(ctx, query, domain.EntregaFalha)
+	if err != nil {
+		return nil, err
+	}
+	return scanEntregas(rows)
+}
+
+func (r *postgresWebhookRepository) buscarWebhooks(ctx context.Context, query string, args ...interface{}) ([]*domain.Webhook, error) {
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	webhooks := []*domain.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// interface comum entre sql.Row e sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWebhook(row scanner) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+	var eventos []string
+	if err := row.Scan(&webhook.Id, &webhook.Url, pq.Array(&eventos), &webhook.Segredo, &webhook.CriadoEm); err != nil {
+		return nil, err
+	}
+	webhook.Eventos = make([]domain.TipoEvento, len(eventos))
+	for i, evento := range eventos {
+		webhook.Eventos[i] = domain.TipoEvento(evento)
+	}
+	return &webhook, nil
+}
+
+func scanEntrega(row scanner) (*domain.EntregaWebhook, error) {
+	var entrega domain.EntregaWebhook
+	var payload []byte
+	if err := row.Scan(&entrega.Id, &entrega.WebhookId, &payload, &entrega.Status, &entrega.Tentativas, &entrega.ProximaTentativa, &entrega.UltimoErro, &entrega.CriadoEm); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, &entrega.Evento); err != nil {
+		return nil, err
+	}
+	return &entrega, nil
+}
+
+func scanEntregas(rows *sql.Rows) ([]*domain.EntregaWebhook, error) {
+	defer rows.Close()
+	entregas := []*domain.EntregaWebhook{}
+	for rows.Next() {
+		entrega, err := scanEntrega(rows)
+		if err != nil {
+			return nil, err
+		}
+		entregas = append(entregas, entrega)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entregas, nil
+}
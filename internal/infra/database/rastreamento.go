@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/flaviorodolfo/transfeera-challenge/internal/infra/database")
+
+var (
+	reLiteralTexto = regexp.MustCompile(`'(?:[^']|'')*'`)
+	reEspacos      = regexp.MustCompile(`\s+`)
+)
+
+// inicia o span de uma operação no banco de dados com a query sanitizada
+func iniciarSpan(ctx context.Context, operacao, query string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "postgres."+operacao,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationName(operacao), semconv.DBQueryText(sanitizarSQL(query))),
+	)
+}
+
+// registra o erro no span e o encerra, sql.ErrNoRows não é considerado erro
+func finalizarSpan(span trace.Span, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// os valores são sempre enviados como parâmetros ($1, $2...), ainda assim qualquer literal de
+// texto é substituído para que dados dos recebedores nunca sejam exportados nos spans
+func sanitizarSQL(query string) string {
+	query = reLiteralTexto.ReplaceAllString(query, "?")
+	return strings.TrimSpace(reEspacos.ReplaceAllString(query, " "))
+}
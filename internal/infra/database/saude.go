@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// verifica se o banco de dados está acessível
+func VerificarConexao(db *sql.DB) func(ctx context.Context) error {
+	return db.PingContext
+}
+
+// verifica se todas as migrações embutidas no binário foram aplicadas
+func VerificarMigracoes(db *sql.DB) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		migrador, err := NewMigrador(db)
+		if err != nil {
+			return err
+		}
+		var versao int
+		if err := db.QueryRowContext(ctx, "SELECT COALESCE(max(versao), 0) FROM public.schema_migrations").Scan(&versao); err != nil {
+			return fmt.Errorf("consultando as migrações aplicadas: %w", err)
+		}
+		if esperada := migrador.VersaoMaisRecente(); versao < esperada {
+			return fmt.Errorf("esquema na versão %d, versão esperada %d", versao, esperada)
+		}
+		return nil
+	}
+}
+
+// verifica se o evento mais antigo ainda não publicado do outbox foi registrado há menos de maxAtraso,
+// os eventos descartados pelo relay não são considerados
+func VerificarAtrasoOutbox(db *sql.DB, maxAtraso time.Duration) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		var atraso float64
+		query := "SELECT COALESCE(EXTRACT(EPOCH FROM now() - min(criado_em)), 0) FROM pagamento.outbox WHERE publicado_em IS NULL AND descartado_em IS NULL"
+		if err := db.QueryRowContext(ctx, query).Scan(&atraso); err != nil {
+			return err
+		}
+		if atrasoOutbox := time.Duration(atraso * float64(time.Second)); atrasoOutbox > maxAtraso {
+			return fmt.Errorf("atraso do outbox de %s excede o limite de %s", atrasoOutbox.Round(time.Second), maxAtraso)
+		}
+		return nil
+	}
+}
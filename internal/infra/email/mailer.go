@@ -0,0 +1,126 @@
+// Package email implementa o domain.Mailer por SMTP e, para o desenvolvimento, gravando os
+// emails em arquivos
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+const timeoutSMTPPadrao = 30 * time.Second
+
+// caracteres que não são usados no nome dos arquivos
+var regexNomeArquivo = regexp.MustCompile(`[^a-zA-Z0-9@._-]`)
+
+// monta o email no formato RFC 5322 em texto simples UTF-8
+func formatar(remetente string, mensagem domain.MensagemEmail, data time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", remetente)
+	fmt.Fprintf(&buf, "To: %s\r\n", mensagem.Para)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", mensagem.Assunto))
+	fmt.Fprintf(&buf, "Date: %s\r\n", data.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(mensagem.Corpo, "\r\n", "\n"), "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
+
+// MailerArquivo grava cada email em um arquivo .eml no diretório informado, para o
+// desenvolvimento sem um servidor SMTP
+type MailerArquivo struct {
+	diretorio string
+	remetente string
+}
+
+// o diretório é criado se não existir
+func NewMailerArquivo(diretorio, remetente string) (*MailerArquivo, error) {
+	if err := os.MkdirAll(diretorio, 0o755); err != nil {
+		return nil, err
+	}
+	return &MailerArquivo{diretorio: diretorio, remetente: remetente}, nil
+}
+
+func (m *MailerArquivo) Enviar(ctx context.Context, mensagem domain.MensagemEmail) error {
+	agora := time.Now()
+	nome := fmt.Sprintf("%d-%s.eml", agora.UnixNano(), regexNomeArquivo.ReplaceAllString(mensagem.Para, "_"))
+	return os.WriteFile(filepath.Join(m.diretorio, nome), formatar(m.remetente, mensagem, agora), 0o644)
+}
+
+// MailerSMTP envia os emails pelo servidor SMTP, usando STARTTLS quando o servidor oferece a
+// extensão e autenticação PLAIN quando o usuário é informado
+type MailerSMTP struct {
+	host      string
+	endereco  string
+	auth      smtp.Auth
+	remetente string
+}
+
+func NewMailerSMTP(host string, porta int, usuario, senha, remetente string) *MailerSMTP {
+	m := &MailerSMTP{host: host, endereco: net.JoinHostPort(host, strconv.Itoa(porta)), remetente: remetente}
+	if usuario != "" {
+		m.auth = smtp.PlainAuth("", usuario, senha, host)
+	}
+	return m
+}
+
+func (m *MailerSMTP) Enviar(ctx context.Context, mensagem domain.MensagemEmail) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.endereco)
+	if err != nil {
+		return err
+	}
+	//o cliente SMTP não recebe o contexto, o prazo do contexto é aplicado à conexão
+	prazo, ok := ctx.Deadline()
+	if !ok {
+		prazo = time.Now().Add(timeoutSMTPPadrao)
+	}
+	conn.SetDeadline(prazo)
+	cliente, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer cliente.Close()
+	if ok, _ := cliente.Extension("STARTTLS"); ok {
+		if err := cliente.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if err := cliente.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+	if err := cliente.Mail(m.remetente); err != nil {
+		return err
+	}
+	if err := cliente.Rcpt(mensagem.Para); err != nil {
+		return err
+	}
+	w, err := cliente.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(formatar(m.remetente, mensagem, time.Now())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return cliente.Quit()
+}
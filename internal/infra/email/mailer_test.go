@@ -0,0 +1,106 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mensagemTeste = domain.MensagemEmail{Para: "ana@transfeera.com", Assunto: "Verificação do email", Corpo: "acesse o link\nhttp://localhost/verificar"}
+
+func TestFormatar(t *testing.T) {
+	conteudo := string(formatar("nao-responda@transfeera.com", mensagemTeste, time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)))
+	assert.Contains(t, conteudo, "From: nao-responda@transfeera.com\r\n")
+	assert.Contains(t, conteudo, "To: ana@transfeera.com\r\n")
+	//o assunto com acentos é codificado
+	assert.Contains(t, conteudo, "Subject: =?utf-8?q?Verifica=C3=A7=C3=A3o_do_email?=\r\n")
+	assert.Contains(t, conteudo, "Date: Sat, 01 Jun 2024 10:00:00 +0000\r\n")
+	assert.True(t, strings.HasSuffix(conteudo, "\r\n\r\nacesse o link\r\nhttp://localhost/verificar\r\n"))
+}
+
+func TestMailerArquivo(t *testing.T) {
+	diretorio := filepath.Join(t.TempDir(), "emails")
+	mailer, err := NewMailerArquivo(diretorio, "nao-responda@transfeera.com")
+	require.NoError(t, err)
+	require.NoError(t, mailer.Enviar(context.Background(), mensagemTeste))
+
+	arquivos, err := os.ReadDir(diretorio)
+	require.NoError(t, err)
+	require.Len(t, arquivos, 1)
+	assert.True(t, strings.HasSuffix(arquivos[0].Name(), "-ana@transfeera.com.eml"))
+	conteudo, err := os.ReadFile(filepath.Join(diretorio, arquivos[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(conteudo), "http://localhost/verificar")
+}
+
+// servidor SMTP mínimo, sem STARTTLS e sem autenticação, que retorna os comandos e os dados recebidos
+func servidorSMTP(t *testing.T) (string, <-chan []string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	recebido := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		leitor := bufio.NewReader(conn)
+		linhas := []string{}
+		responder := func(resposta string) { conn.Write([]byte(resposta + "\r\n")) }
+		responder("220 localhost ESMTP")
+		dados := false
+		for {
+			linha, err := leitor.ReadString('\n')
+			if err != nil {
+				recebido <- linhas
+				return
+			}
+			linha = strings.TrimRight(linha, "\r\n")
+			linhas = append(linhas, linha)
+			switch {
+			case dados && linha == ".":
+				dados = false
+				responder("250 OK")
+			case dados:
+			case strings.HasPrefix(linha, "EHLO"):
+				responder("250-localhost\r\n250 8BITMIME")
+			case linha == "DATA":
+				dados = true
+				responder("354 envie os dados")
+			case linha == "QUIT":
+				responder("221 tchau")
+				recebido <- linhas
+				return
+			default:
+				responder("250 OK")
+			}
+		}
+	}()
+	return listener.Addr().String(), recebido
+}
+
+func TestMailerSMTP(t *testing.T) {
+	endereco, recebido := servidorSMTP(t)
+	host, porta, _ := net.SplitHostPort(endereco)
+	numeroPorta, _ := strconv.Atoi(porta)
+	mailer := NewMailerSMTP(host, numeroPorta, "", "", "nao-responda@transfeera.com")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, mailer.Enviar(ctx, mensagemTeste))
+	linhas := strings.Join(<-recebido, "\n")
+	assert.Contains(t, linhas, "MAIL FROM:<nao-responda@transfeera.com>")
+	assert.Contains(t, linhas, "RCPT TO:<ana@transfeera.com>")
+	assert.Contains(t, linhas, "http://localhost/verificar")
+}
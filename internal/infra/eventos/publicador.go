@@ -0,0 +1,108 @@
+package eventos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+const timeoutHTTPPadrao = 10 * time.Second
+
+// PublicadorStream escreve cada evento como uma linha JSON no writer informado
+type PublicadorStream struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewPublicadorStream(w io.Writer) *PublicadorStream {
+	return &PublicadorStream{w: w}
+}
+
+// publicador que escreve os eventos na saída padrão
+func NewPublicadorStdout() *PublicadorStream {
+	return NewPublicadorStream(os.Stdout)
+}
+
+func (p *PublicadorStream) Publicar(ctx context.Context, evento domain.Evento) error {
+	linha, err := json.Marshal(evento)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err = p.w.Write(append(linha, '\n'))
+	return err
+}
+
+// PublicadorArquivo acrescenta os eventos como linhas JSON ao final de um arquivo
+type PublicadorArquivo struct {
+	*PublicadorStream
+	arquivo *os.File
+}
+
+func NewPublicadorArquivo(caminho string) (*PublicadorArquivo, error) {
+	arquivo, err := os.OpenFile(caminho, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicadorArquivo{PublicadorStream: NewPublicadorStream(arquivo), arquivo: arquivo}, nil
+}
+
+func (p *PublicadorArquivo) Close() error {
+	return p.arquivo.Close()
+}
+
+// PublicadorHTTP envia cada evento em um POST JSON para a url informada,
+// qualquer status diferente de 2xx é considerado falha
+type PublicadorHTTP struct {
+	url    string
+	client *http.Client
+}
+
+func NewPublicadorHTTP(url string) *PublicadorHTTP {
+	return &PublicadorHTTP{url: url, client: &http.Client{Timeout: timeoutHTTPPadrao}}
+}
+
+func (p *PublicadorHTTP) Publicar(ctx context.Context, evento domain.Evento) error {
+	corpo, err := json.Marshal(evento)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(corpo))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("publicador http respondeu com status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// PublicadorMultiplo repassa cada evento para todos os publicadores, retornando
+// os erros de todos os publicadores que falharam
+type PublicadorMultiplo []domain.PublicadorEventos
+
+func (p PublicadorMultiplo) Publicar(ctx context.Context, evento domain.Evento) error {
+	var erros []error
+	for _, publicador := range p {
+		if err := publicador.Publicar(ctx, evento); err != nil {
+			erros = append(erros, err)
+		}
+	}
+	return errors.Join(erros...)
+}
@@ -0,0 +1,88 @@
+package eventos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+var eventoTeste = domain.Evento{Id: "1", Tipo: domain.EventoRecebedorCriado, Recebedor: &domain.Recebedor{Id: 1}}
+
+func TestPublicadorStream(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPublicadorStream(&buf)
+	assert.NoError(t, p.Publicar(context.Background(), eventoTeste))
+	assert.NoError(t, p.Publicar(context.Background(), eventoTeste))
+
+	linhas := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, linhas, 2)
+	var evento domain.Evento
+	assert.NoError(t, json.Unmarshal([]byte(linhas[0]), &evento))
+	assert.Equal(t, eventoTeste.Tipo, evento.Tipo)
+}
+
+func TestPublicadorArquivo(t *testing.T) {
+	caminho := filepath.Join(t.TempDir(), "eventos.jsonl")
+	p, err := NewPublicadorArquivo(caminho)
+	assert.NoError(t, err)
+	assert.NoError(t, p.Publicar(context.Background(), eventoTeste))
+	assert.NoError(t, p.Close())
+
+	//o arquivo é aberto em modo append, os eventos anteriores são mantidos
+	p, err = NewPublicadorArquivo(caminho)
+	assert.NoError(t, err)
+	assert.NoError(t, p.Publicar(context.Background(), eventoTeste))
+	assert.NoError(t, p.Close())
+
+	conteudo, err := os.ReadFile(caminho)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(conteudo), "\n"))
+}
+
+func TestPublicadorHTTP(t *testing.T) {
+	var evento domain.Evento
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&evento)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	err := NewPublicadorHTTP(server.URL).Publicar(context.Background(), eventoTeste)
+	assert.NoError(t, err)
+	assert.Equal(t, eventoTeste.Id, evento.Id)
+}
+
+func TestPublicadorHTTP_StatusErro(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewPublicadorHTTP(server.URL).Publicar(context.Background(), eventoTeste)
+	assert.ErrorContains(t, err, "503")
+}
+
+type publicadorFalho struct{}
+
+func (publicadorFalho) Publicar(ctx context.Context, evento domain.Evento) error {
+	return errors.New("indisponível")
+}
+
+func TestPublicadorMultiplo(t *testing.T) {
+	var buf bytes.Buffer
+	p := PublicadorMultiplo{publicadorFalho{}, NewPublicadorStream(&buf)}
+	err := p.Publicar(context.Background(), eventoTeste)
+	//a falha de um publicador não impede a publicação nos demais
+	assert.Error(t, err)
+	assert.NotEmpty(t, buf.String())
+}
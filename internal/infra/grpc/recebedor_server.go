@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/grpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recebedorServer struct {
+	pb.UnimplementedRecebedorServiceServer
+	service *app.RecebedorService
+}
+
+func paraPb(recebedor *domain.Recebedor) *pb.Recebedor {
+	return &pb.Recebedor{
+		Id:                 uint64(recebedor.Id),
+		CpfCnpj:            recebedor.CpfCnpj,
+		Nome:               recebedor.Nome,
+		TipoChavePix:       string(recebedor.TipoChavePix),
+		ChavePix:           recebedor.ChavePix,
+		ChavePixFormatada:  recebedor.ChavePixFormatada,
+		TipoCorrespondente: string(recebedor.TipoCorrespondente),
+		Status:             recebedor.Status,
+		Email:              recebedor.Email,
+	}
+}
+
+func paraDomain(recebedor *pb.Recebedor) *domain.Recebedor {
+	return &domain.Recebedor{
+		Id:           uint(recebedor.GetId()),
+		CpfCnpj:      recebedor.GetCpfCnpj(),
+		Nome:         recebedor.GetNome(),
+		TipoChavePix: domain.TipoChavePix(recebedor.GetTipoChavePix()),
+		ChavePix:     recebedor.GetChavePix(),
+		Status:       recebedor.GetStatus(),
+		Email:        recebedor.GetEmail(),
+	}
+}
+
+func paraIds(ids []uint) []uint64 {
+	resultado := make([]uint64, len(ids))
+	for i, id := range ids {
+		resultado[i] = uint64(id)
+	}
+	return resultado
+}
+
+func (s *recebedorServer) CriarRecebedor(ctx context.Context, req *pb.CriarRecebedorRequest) (*pb.CriarRecebedorResponse, error) {
+	if req.GetRecebedor() == nil {
+		return nil, status.Error(codes.InvalidArgument, "recebedor é obrigatório")
+	}
+	recebedor := paraDomain(req.GetRecebedor())
+	recebedor.Id = 0
+	if err := s.service.CriarRecebedor(ctx, recebedor); err != nil {
+		return nil, err
+	}
+	return &pb.CriarRecebedorResponse{Recebedor: paraPb(recebedor)}, nil
+}
+
+func (s *recebedorServer) EditarRecebedor(ctx context.Context, req *pb.EditarRecebedorRequest) (*pb.EditarRecebedorResponse, error) {
+	if req.GetRecebedor() == nil {
+		return nil, status.Error(codes.InvalidArgument, "recebedor é obrigatório")
+	}
+	if err := s.service.EditarRecebedor(ctx, paraDomain(req.GetRecebedor())); err != nil {
+		return nil, err
+	}
+	return &pb.EditarRecebedorResponse{}, nil
+}
+
+func (s *recebedorServer) EditarEmailRecebedor(ctx context.Context, req *pb.EditarEmailRecebedorRequest) (*pb.EditarEmailRecebedorResponse, error) {
+	if err := s.service.EditarEmailRecebedor(ctx, uint(req.GetId()), req.GetEmail()); err != nil {
+		return nil, err
+	}
+	return &pb.EditarEmailRecebedorResponse{}, nil
+}
+
+func (s *recebedorServer) ValidarRecebedor(ctx context.Context, req *pb.ValidarRecebedorRequest) (*pb.ValidarRecebedorResponse, error) {
+	if err := s.service.ValidarRecebedor(ctx, uint(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &pb.ValidarRecebedorResponse{}, nil
+}
+
+func (s *recebedorServer) BuscarRecebedor(ctx context.Context, req *pb.BuscarRecebedorRequest) (*pb.BuscarRecebedorResponse, error) {
+	recebedor, err := s.service.BuscarRecebedorById(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BuscarRecebedorResponse{Recebedor: paraPb(recebedor)}, nil
+}
+
+// consulta a página do filtro no serviço, o filtro é obrigatório
+func (s *recebedorServer) pesquisar(ctx context.Context, filtro *pb.FiltroRecebedores, pagina int) (*domain.PaginaRecebedores, error) {
+	switch campo := filtro.GetCampo().(type) {
+	case *pb.FiltroRecebedores_Nome:
+		return s.service.BuscarRecebedoresPorNome(ctx, campo.Nome, pagina)
+	case *pb.FiltroRecebedores_Status:
+		return s.service.BuscarRecebedoresPorStatus(ctx, campo.Status, pagina)
+	case *pb.FiltroRecebedores_Chave:
+		return s.service.BuscarRecebedoresPorChave(ctx, campo.Chave, filtro.GetTipoChave(), pagina)
+	case *pb.FiltroRecebedores_TipoChavePix:
+		return s.service.BuscarRecebedoresPorTipoChavePix(ctx, campo.TipoChavePix, pagina)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "filtro é obrigatório")
+	}
+}
+
+func (s *recebedorServer) PesquisarRecebedores(ctx context.Context, req *pb.PesquisarRecebedoresRequest) (*pb.PesquisarRecebedoresResponse, error) {
+	pagina := int(req.GetPagina())
+	if pagina < 0 {
+		return nil, status.Error(codes.InvalidArgument, "parâmetro de página inválido")
+	}
+	resultado, err := s.pesquisar(ctx, req.GetFiltro(), max(pagina, 1))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PesquisarRecebedoresResponse{
+		Total:        int32(resultado.Total),
+		PorPagina:    int32(resultado.PorPagina),
+		PaginaAtual:  int32(resultado.PaginaAtual),
+		TotalPaginas: int32(resultado.TotalPaginas),
+	}
+	for _, recebedor := range resultado.Recebedores {
+		resp.Recebedores = append(resp.Recebedores, paraPb(recebedor))
+	}
+	for _, tipo := range resultado.TiposChave {
+		resp.TiposChave = append(resp.TiposChave, string(tipo))
+	}
+	return resp, nil
+}
+
+// percorre as páginas do filtro até a última, interrompendo se o cliente cancelar a chamada
+func (s *recebedorServer) ListarRecebedores(req *pb.ListarRecebedoresRequest, stream pb.RecebedorService_ListarRecebedoresServer) error {
+	ctx := stream.Context()
+	for pagina := 1; ; pagina++ {
+		resultado, err := s.pesquisar(ctx, req.GetFiltro(), pagina)
+		if err != nil {
+			return err
+		}
+		for _, recebedor := range resultado.Recebedores {
+			if err := stream.Send(paraPb(recebedor)); err != nil {
+				return err
+			}
+		}
+		if pagina >= resultado.TotalPaginas {
+			return nil
+		}
+	}
+}
+
+func (s *recebedorServer) DeletarRecebedor(ctx context.Context, req *pb.DeletarRecebedorRequest) (*pb.DeletarRecebedorResponse, error) {
+	if err := s.service.DeletarRecebedor(ctx, uint(req.GetId())); err != nil {
+		return nil, err
+	}
+	return &pb.DeletarRecebedorResponse{}, nil
+}
+
+// a deleção parcial não é um erro, os ids não deletados são retornados na resposta
+func (s *recebedorServer) DeletarRecebedores(ctx context.Context, req *pb.DeletarRecebedoresRequest) (*pb.DeletarRecebedoresResponse, error) {
+	ids := make([]uint, len(req.GetIds()))
+	for i, id := range req.GetIds() {
+		ids[i] = uint(id)
+	}
+	err := s.service.DeletarRecebedores(ctx, ids)
+	var naoDeletados domain.ErrRecebedoresNaoDeletados
+	if errors.As(err, &naoDeletados) {
+		return &pb.DeletarRecebedoresResponse{
+			IdsComSucesso: paraIds(naoDeletados.IdsComSucesso),
+			IdsSemSucesso: paraIds(naoDeletados.IdsSemSucesso),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeletarRecebedoresResponse{IdsComSucesso: req.GetIds()}, nil
+}
@@ -0,0 +1,107 @@
+// Package grpc expõe o RecebedorService pela api gRPC definida em pb/recebedores.proto,
+// compartilhando a mesma instância do serviço usada pela api REST
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/brcode"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/grpc/pb"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// código gRPC de cada erro conhecido, os demais erros são retornados como Internal
+var codigosErros = []struct {
+	err    error
+	codigo codes.Code
+}{
+	{domain.ErrEmailInvalido, codes.InvalidArgument},
+	{domain.ErrNomeInvalido, codes.InvalidArgument},
+	{domain.ErrChaveInvalida, codes.InvalidArgument},
+	{domain.ErrTipoChaveInvalida, codes.InvalidArgument},
+	{domain.ErrChaveTipoNaoCorresponde, codes.InvalidArgument},
+	{domain.ErrCpfInvalido, codes.InvalidArgument},
+	{domain.ErrCnpjInvalido, codes.InvalidArgument},
+	{brcode.ErrValorInvalido, codes.InvalidArgument},
+	{brcode.ErrTxIdInvalido, codes.InvalidArgument},
+	{brcode.ErrCampoMuitoLongo, codes.InvalidArgument},
+	{brcode.ErrBrCodeInvalido, codes.InvalidArgument},
+	{brcode.ErrCrcInvalido, codes.InvalidArgument},
+	{brcode.ErrChaveAusente, codes.InvalidArgument},
+	{domain.ErrRecebedorNaoEncontrado, codes.NotFound},
+	{domain.ErrChavePixJaCadastrada, codes.AlreadyExists},
+	{domain.ErrRecebedorNaoPermiteEdicao, codes.FailedPrecondition},
+	{domain.ErrRecebedorBloqueado, codes.FailedPrecondition},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+	{context.Canceled, codes.Canceled},
+}
+
+// converte o erro do serviço em um status gRPC, assim como o ErrorHandler da api REST
+// a mensagem de erros desconhecidos não é exposta ao cliente
+func traduzirErro(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	for _, conhecido := range codigosErros {
+		if errors.Is(err, conhecido.err) {
+			mensagem := err.Error()
+			if conhecido.codigo == codes.DeadlineExceeded {
+				mensagem = "tempo limite da operação excedido"
+			}
+			return status.Error(conhecido.codigo, mensagem)
+		}
+	}
+	return status.Error(codes.Internal, "erro interno no servidor")
+}
+
+// registra o erro original, contabilizando-o nas métricas como o middleware da api REST, e
+// retorna o status gRPC correspondente
+func interceptorErros(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			rastreamento.Logger(ctx, logger).Error("requisição grpc", zap.String("metodo", info.FullMethod), zap.Error(err))
+			metricas.RegistrarErro(err)
+		}
+		return resp, traduzirErro(err)
+	}
+}
+
+func interceptorErrosStream(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		if err != nil {
+			rastreamento.Logger(stream.Context(), logger).Error("requisição grpc", zap.String("metodo", info.FullMethod), zap.Error(err))
+			metricas.RegistrarErro(err)
+		}
+		return traduzirErro(err)
+	}
+}
+
+// NewServer cria o servidor gRPC com o RecebedorService, o serviço de saúde padrão
+// (grpc.health.v1) e a reflexão dos serviços para clientes como o grpcurl
+func NewServer(service *app.RecebedorService, logger *zap.Logger, opcoes ...grpc.ServerOption) *grpc.Server {
+	opcoes = append(opcoes,
+		grpc.ChainUnaryInterceptor(interceptorErros(logger)),
+		grpc.ChainStreamInterceptor(interceptorErrosStream(logger)),
+	)
+	server := grpc.NewServer(opcoes...)
+	pb.RegisterRecebedorServiceServer(server, &recebedorServer{service: service})
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	reflection.Register(server)
+	return server
+}
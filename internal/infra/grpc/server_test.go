@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/grpc/pb"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/memoria"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// inicia o servidor em memória e retorna um cliente conectado a ele
+func novoCliente(t *testing.T) pb.RecebedorServiceClient {
+	service := app.NewRecebedorService(memoria.NewRecebedorRepository(), zap.NewNop(), app.ComTamanhoPagina(2))
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(service, zap.NewNop())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conexao, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conexao.Close() })
+	return pb.NewRecebedorServiceClient(conexao)
+}
+
+func criar(t *testing.T, cliente pb.RecebedorServiceClient, nome, email string) *pb.Recebedor {
+	resp, err := cliente.CriarRecebedor(context.Background(), &pb.CriarRecebedorRequest{Recebedor: &pb.Recebedor{
+		CpfCnpj: "783.852.830-56", Nome: nome, TipoChavePix: "EMAIL", ChavePix: email, Email: email,
+	}})
+	require.NoError(t, err)
+	return resp.GetRecebedor()
+}
+
+func TestServer_CriarBuscarEditar(t *testing.T) {
+	ctx := context.Background()
+	cliente := novoCliente(t)
+	criado := criar(t, cliente, "ana", "ana@transfeera.com")
+	assert.NotZero(t, criado.GetId())
+	assert.Equal(t, domain.StatusRascunho, criado.GetStatus())
+
+	editado := &pb.Recebedor{Id: criado.GetId(), CpfCnpj: criado.GetCpfCnpj(), Nome: "ana maria", TipoChavePix: "EMAIL", ChavePix: "ana.maria@transfeera.com", Email: criado.GetEmail()}
+	_, err := cliente.EditarRecebedor(ctx, &pb.EditarRecebedorRequest{Recebedor: editado})
+	require.NoError(t, err)
+	_, err = cliente.ValidarRecebedor(ctx, &pb.ValidarRecebedorRequest{Id: criado.GetId()})
+	require.NoError(t, err)
+
+	resp, err := cliente.BuscarRecebedor(ctx, &pb.BuscarRecebedorRequest{Id: criado.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, "ana maria", resp.GetRecebedor().GetNome())
+	assert.Equal(t, domain.StatusValidado, resp.GetRecebedor().GetStatus())
+
+	//recebedores validados permitem apenas a edição do email
+	_, err = cliente.EditarRecebedor(ctx, &pb.EditarRecebedorRequest{Recebedor: editado})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = cliente.EditarEmailRecebedor(ctx, &pb.EditarEmailRecebedorRequest{Id: criado.GetId(), Email: "nova@transfeera.com"})
+	assert.NoError(t, err)
+}
+
+func TestServer_Erros(t *testing.T) {
+	ctx := context.Background()
+	cliente := novoCliente(t)
+
+	naoEncontrados := testutil.ToFloat64(metricas.ErrosServico.WithLabelValues("recebedor_nao_encontrado"))
+	_, err := cliente.BuscarRecebedor(ctx, &pb.BuscarRecebedorRequest{Id: 99})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, domain.ErrRecebedorNaoEncontrado.Error(), status.Convert(err).Message())
+	//os erros da api gRPC são contabilizados assim como os da api REST
+	assert.Equal(t, naoEncontrados+1, testutil.ToFloat64(metricas.ErrosServico.WithLabelValues("recebedor_nao_encontrado")))
+
+	_, err = cliente.CriarRecebedor(ctx, &pb.CriarRecebedorRequest{Recebedor: &pb.Recebedor{
+		CpfCnpj: "783.852.830-56", Nome: "ana", TipoChavePix: "EMAIL", ChavePix: "ana@transfeera.com", Email: "email inválido",
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = cliente.CriarRecebedor(ctx, &pb.CriarRecebedorRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = cliente.PesquisarRecebedores(ctx, &pb.PesquisarRecebedoresRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTraduzirErro(t *testing.T) {
+	assert.Nil(t, traduzirErro(nil))
+	assert.Equal(t, codes.AlreadyExists, status.Code(traduzirErro(fmt.Errorf("criando: %w", domain.ErrChavePixJaCadastrada))))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(traduzirErro(context.DeadlineExceeded)))
+
+	interno := status.Convert(traduzirErro(errors.New("conexão recusada")))
+	assert.Equal(t, codes.Internal, interno.Code())
+	assert.Equal(t, "erro interno no servidor", interno.Message())
+}
+
+func TestServer_PesquisarEListar(t *testing.T) {
+	ctx := context.Background()
+	cliente := novoCliente(t)
+	ids := []uint64{}
+	for i := 0; i < 5; i++ {
+		ids = append(ids, criar(t, cliente, "maria", fmt.Sprintf("maria%d@transfeera.com", i)).GetId())
+	}
+	filtro := &pb.FiltroRecebedores{Campo: &pb.FiltroRecebedores_Nome{Nome: "maria"}}
+
+	pagina, err := cliente.PesquisarRecebedores(ctx, &pb.PesquisarRecebedoresRequest{Filtro: filtro, Pagina: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), pagina.GetTotal())
+	assert.Equal(t, int32(3), pagina.GetTotalPaginas())
+	assert.Len(t, pagina.GetRecebedores(), 2)
+
+	stream, err := cliente.ListarRecebedores(ctx, &pb.ListarRecebedoresRequest{Filtro: filtro})
+	require.NoError(t, err)
+	listados := []uint64{}
+	for {
+		recebedor, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		listados = append(listados, recebedor.GetId())
+	}
+	assert.Equal(t, ids, listados)
+}
+
+func TestServer_DeletarRecebedores(t *testing.T) {
+	ctx := context.Background()
+	cliente := novoCliente(t)
+	primeiro := criar(t, cliente, "ana", "ana@transfeera.com")
+	segundo := criar(t, cliente, "pedro", "pedro@transfeera.com")
+
+	resp, err := cliente.DeletarRecebedores(ctx, &pb.DeletarRecebedoresRequest{Ids: []uint64{primeiro.GetId(), 99}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{primeiro.GetId()}, resp.GetIdsComSucesso())
+	assert.Equal(t, []uint64{99}, resp.GetIdsSemSucesso())
+
+	resp, err = cliente.DeletarRecebedores(ctx, &pb.DeletarRecebedoresRequest{Ids: []uint64{segundo.GetId()}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{segundo.GetId()}, resp.GetIdsComSucesso())
+	assert.Empty(t, resp.GetIdsSemSucesso())
+}
@@ -1,9 +1,12 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	"github.com/flaviorodolfo/transfeera-challenge/internal/brcode"
 	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
 	"github.com/gin-gonic/gin"
 )
@@ -36,16 +39,29 @@ func ErrorHandler() gin.HandlerFunc {
 			var status int
 			var message interface{}
 
+			//o erro de tempo esgotado normalmente chega encapsulado pelo repositório
+			if errors.Is(err, context.DeadlineExceeded) {
+				err = context.DeadlineExceeded
+			}
+
 			switch err {
-			case domain.ErrEmailInvalido, domain.ErrChavePixJaCadastrada, domain.ErrCpfInvalido, domain.ErrChaveTipoNaoCorresponde, domain.ErrCnpjInvalido, domain.ErrNomeInvalido, domain.ErrTipoChaveInvalida, domain.ErrChaveInvalida:
+			case domain.ErrEmailInvalido, domain.ErrChavePixJaCadastrada, domain.ErrCpfInvalido, domain.ErrChaveTipoNaoCorresponde, domain.ErrCnpjInvalido, domain.ErrNomeInvalido, domain.ErrTipoChaveInvalida, domain.ErrChaveInvalida,
+				brcode.ErrValorInvalido, brcode.ErrTxIdInvalido, brcode.ErrCampoMuitoLongo, brcode.ErrBrCodeInvalido,
+				brcode.ErrCrcInvalido, brcode.ErrChaveAusente, domain.ErrUrlWebhookInvalida, domain.ErrEventoInvalido,
+				domain.ErrDecisaoInvalida, domain.ErrStatusOcorrenciaInvalido, domain.ErrTokenVerificacaoInvalido,
+				domain.ErrTokenVerificacaoExpirado:
 				status = http.StatusBadRequest
 				message = err.Error()
-			case domain.ErrRecebedorNaoEncontrado:
+			case domain.ErrRecebedorNaoEncontrado, domain.ErrWebhookNaoEncontrado, domain.ErrEntregaNaoEncontrada, domain.ErrOcorrenciaNaoEncontrada:
 				status = http.StatusNotFound
 				message = err.Error()
-			case domain.ErrRecebedorNaoPermiteEdicao:
+			case domain.ErrRecebedorNaoPermiteEdicao, domain.ErrRecebedorBloqueado, domain.ErrOcorrenciaJaRevisada,
+				domain.ErrEmailJaVerificado:
 				status = http.StatusConflict
 				message = err.Error()
+			case context.DeadlineExceeded:
+				status = http.StatusGatewayTimeout
+				message = "tempo limite da operação excedido"
 			default:
 				status = http.StatusInternalServerError
 				message = "erro interno no servidor"
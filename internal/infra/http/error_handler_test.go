@@ -0,0 +1,48 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorHandler(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	tests := map[string]struct {
+		err    error
+		status int
+	}{"erro conhecido": {
+		err:    domain.ErrRecebedorNaoEncontrado,
+		status: http.StatusNotFound,
+	},
+		"tempo esgotado": {
+			err:    context.DeadlineExceeded,
+			status: http.StatusGatewayTimeout,
+		},
+		"tempo esgotado encapsulado": {
+			err:    fmt.Errorf("consultando recebedor: %w", context.DeadlineExceeded),
+			status: http.StatusGatewayTimeout,
+		},
+		"erro inesperado": {
+			err:    fmt.Errorf("erro inesperado"),
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(ErrorHandler())
+			router.GET("/", func(c *gin.Context) { c.Error(tt.err) })
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/", nil))
+			assert.Equal(t, tt.status, resp.Code)
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package http
 
 import (
+	"math"
 	"net/http"
 	"strconv"
 
 	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/brcode"
 	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"go.uber.org/zap"
@@ -16,10 +19,21 @@ type RecebedorHandler struct {
 	logger  *zap.Logger
 }
 
+// logger com os identificadores do trace da requisição
+func (h *RecebedorHandler) log(c *gin.Context) *zap.Logger {
+	return rastreamento.Logger(c.Request.Context(), h.logger)
+}
+
 type deleteRequest struct {
 	Ids []uint `json:"ids"`
 }
 
+type brCodeRequest struct {
+	BrCode  string `json:"brcode" validate:"required"`
+	CpfCnpj string `json:"cpf_cnpj"`
+	Email   string `json:"email"`
+}
+
 func formatarErroCampos(validationErrors validator.ValidationErrors) []map[string]string {
 	errors := make([]map[string]string, len(validationErrors))
 	for i, ve := range validationErrors {
@@ -34,13 +48,13 @@ func formatarErroCampos(validationErrors validator.ValidationErrors) []map[strin
 func (h *RecebedorHandler) CriarRecebedor(c *gin.Context) {
 	var recebedor domain.Recebedor
 	if err := c.ShouldBindJSON(&recebedor); err != nil {
-		h.logger.Error("Binding json", zap.Error(err))
+		h.log(c).Error("Binding json", zap.Error(err))
 		c.Error(err)
 		return
 	}
 	validate := validator.New()
 	if err := validate.Struct(&recebedor); err != nil {
-		h.logger.Error("validação de campos", zap.Error(err))
+		h.log(c).Error("validação de campos", zap.Error(err))
 		campos := formatarErroCampos(err.(validator.ValidationErrors))
 		c.JSON(http.StatusBadRequest, gin.H{
 			"message": "campos obrigatórios",
@@ -48,9 +62,9 @@ func (h *RecebedorHandler) CriarRecebedor(c *gin.Context) {
 		})
 		return
 	}
-	err := h.service.CriarRecebedor(&recebedor)
+	err := h.service.CriarRecebedor(c.Request.Context(), &recebedor)
 	if err != nil {
-		h.logger.Error("Criando recebedor", zap.Error(err))
+		h.log(c).Error("Criando recebedor", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -60,14 +74,14 @@ func (h *RecebedorHandler) CriarRecebedor(c *gin.Context) {
 func (h *RecebedorHandler) EditarRecebedor(c *gin.Context) {
 	var recebedor domain.Recebedor
 	if err := c.ShouldBindJSON(&recebedor); err != nil {
-		h.logger.Error("Binding json", zap.Error(err))
+		h.log(c).Error("Binding json", zap.Error(err))
 		c.Error(err)
 		return
 	}
 
-	err := h.service.EditarRecebedor(&recebedor)
+	err := h.service.EditarRecebedor(c.Request.Context(), &recebedor)
 	if err != nil {
-		h.logger.Error("editando recebedor", zap.Error(err))
+		h.log(c).Error("editando recebedor", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -77,7 +91,7 @@ func (h *RecebedorHandler) EditarRecebedor(c *gin.Context) {
 func (h *RecebedorHandler) EditarEmailRecebedor(c *gin.Context) {
 	var recebedor domain.Recebedor
 	if err := c.ShouldBindJSON(&recebedor); err != nil {
-		h.logger.Error("Binding json", zap.Error(err))
+		h.log(c).Error("Binding json", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -91,9 +105,9 @@ func (h *RecebedorHandler) EditarEmailRecebedor(c *gin.Context) {
 		return
 	}
 	var id = uint(idTmp)
-	err = h.service.EditarEmailRecebedor(id, recebedor.Email)
+	err = h.service.EditarEmailRecebedor(c.Request.Context(), id, recebedor.Email)
 	if err != nil {
-		h.logger.Error("editando recebedor", zap.Error(err))
+		h.log(c).Error("editando recebedor", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -111,9 +125,9 @@ func (h *RecebedorHandler) BuscarRecebedorPorId(c *gin.Context) {
 		return
 	}
 	var id = uint(idTmp)
-	recebedor, err := h.service.BuscarRecebedorById(id)
+	recebedor, err := h.service.BuscarRecebedorById(c.Request.Context(), id)
 	if err != nil {
-		h.logger.Error("consultando recebedor por id", zap.Error(err))
+		h.log(c).Error("consultando recebedor por id", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -131,9 +145,28 @@ func (h *RecebedorHandler) DeletarRecebedor(c *gin.Context) {
 		return
 	}
 	var id = uint(idTmp)
-	err = h.service.DeletarRecebedor(id)
+	err = h.service.DeletarRecebedor(c.Request.Context(), id)
 	if err != nil {
-		h.logger.Error("deletando recebedor", zap.Error(err))
+		h.log(c).Error("deletando recebedor", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func (h *RecebedorHandler) ValidarRecebedor(c *gin.Context) {
+	idStr := c.Param("id")
+	idTmp, err := strconv.Atoi(idStr)
+	if err != nil || idTmp < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "id inválido",
+		})
+		return
+	}
+	err = h.service.ValidarRecebedor(c.Request.Context(), uint(idTmp))
+	if err != nil {
+		h.log(c).Error("validando recebedor", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -149,9 +182,9 @@ func (h *RecebedorHandler) DeletarRecebedores(c *gin.Context) {
 		})
 		return
 	}
-	err := h.service.DeletarRecebedores(body.Ids)
+	err := h.service.DeletarRecebedores(c.Request.Context(), body.Ids)
 	if err != nil {
-		h.logger.Error("deletando recebedores", zap.Error(err))
+		h.log(c).Error("deletando recebedores", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -165,9 +198,9 @@ func (h *RecebedorHandler) BuscarRecebedorPorNome(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "parâmetro de página inválido"})
 		return
 	}
-	recebedor, err := h.service.BuscarRecebedoresPorNome(nome, pagina)
+	recebedor, err := h.service.BuscarRecebedoresPorNome(c.Request.Context(), nome, pagina)
 	if err != nil {
-		h.logger.Error("consultando recebedor por nome", zap.Error(err))
+		h.log(c).Error("consultando recebedor por nome", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -181,9 +214,9 @@ func (h *RecebedorHandler) BuscarRecebedorPorStatus(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "parâmetro de página inválido"})
 		return
 	}
-	recebedores, err := h.service.BuscarRecebedoresPorStatus(status, pagina)
+	recebedores, err := h.service.BuscarRecebedoresPorStatus(c.Request.Context(), status, pagina)
 	if err != nil {
-		h.logger.Error("consultando recebedor", zap.Error(err))
+		h.log(c).Error("consultando recebedor", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -198,9 +231,10 @@ func (h *RecebedorHandler) BuscarRecebedorPorChave(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Parâmetro de página inválido"})
 		return
 	}
-	recebedores, err := h.service.BuscarRecebedoresPorChave(chave, pagina)
+	tipoChave := c.Query("tipo")
+	recebedores, err := h.service.BuscarRecebedoresPorChave(c.Request.Context(), chave, tipoChave, pagina)
 	if err != nil {
-		h.logger.Error("consultando recebedor por chave", zap.Error(err))
+		h.log(c).Error("consultando recebedor por chave", zap.Error(err))
 		c.Error(err)
 		return
 	}
@@ -214,11 +248,91 @@ func (h *RecebedorHandler) BuscarRecebedorPorTipoChave(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Parâmetro de página inválido"})
 		return
 	}
-	recebedores, err := h.service.BuscarRecebedoresPorTipoChavePix(tipoChave, pagina)
+	recebedores, err := h.service.BuscarRecebedoresPorTipoChavePix(c.Request.Context(), tipoChave, pagina)
 	if err != nil {
-		h.logger.Error("consultando recebedor por tipo chave", zap.Error(err))
+		h.log(c).Error("consultando recebedor por tipo chave", zap.Error(err))
 		c.Error(err)
 		return
 	}
 	c.JSON(http.StatusOK, recebedores)
 }
+
+func (h *RecebedorHandler) GerarBrCode(c *gin.Context) {
+	idStr := c.Param("id")
+	idTmp, err := strconv.Atoi(idStr)
+	if err != nil || idTmp < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "id inválido",
+		})
+		return
+	}
+	var valor float64
+	if valorStr := c.Query("valor"); valorStr != "" {
+		valor, err = strconv.ParseFloat(valorStr, 64)
+		if err != nil || math.IsNaN(valor) || math.IsInf(valor, 0) {
+			c.Error(brcode.ErrValorInvalido)
+			return
+		}
+	}
+	//tamanhos acima do máximo são reduzidos por GerarQRCode
+	var tamanho int
+	if tamanhoStr := c.Query("tamanho"); tamanhoStr != "" {
+		tamanho, err = strconv.Atoi(tamanhoStr)
+		if err != nil || tamanho < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"status":  http.StatusBadRequest,
+				"message": "tamanho inválido",
+			})
+			return
+		}
+	}
+	codigo, err := h.service.GerarBrCode(c.Request.Context(), uint(idTmp), valor, c.Query("txid"), c.Query("descricao"))
+	if err != nil {
+		h.log(c).Error("gerando br code", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	if c.Query("formato") == "png" {
+		png, err := brcode.GerarQRCode(codigo, tamanho)
+		if err != nil {
+			h.log(c).Error("gerando qr code", zap.Error(err))
+			c.Error(err)
+			return
+		}
+		c.Data(http.StatusOK, "image/png", png)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"brcode": codigo})
+}
+
+func (h *RecebedorHandler) CriarRecebedorPorBrCode(c *gin.Context) {
+	var body brCodeRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.log(c).Error("Binding json", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	validate := validator.New()
+	if err := validate.Struct(&body); err != nil {
+		h.log(c).Error("validação de campos", zap.Error(err))
+		campos := formatarErroCampos(err.(validator.ValidationErrors))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "campos obrigatórios",
+			"campos":  campos,
+		})
+		return
+	}
+	preview := c.Query("preview") == "true"
+	recebedor, err := h.service.CriarRecebedorPorBrCode(c.Request.Context(), body.BrCode, body.CpfCnpj, body.Email, preview)
+	if err != nil {
+		h.log(c).Error("criando recebedor por br code", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	if preview {
+		c.JSON(http.StatusOK, recebedor)
+		return
+	}
+	c.JSON(http.StatusCreated, recebedor)
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/limite"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	GrupoLeitura = "leitura"
+	GrupoEscrita = "escrita"
+	GrupoLote    = "lote"
+)
+
+// rotas de operações em lote, limitadas separadamente das escritas
+var rotasLote = map[string]bool{
+	"/api/v1/recebedores/deletar": true,
+}
+
+// identificadores de cliente fora do formato são ignorados e o ip é usado no lugar
+var regexCliente = regexp.MustCompile(`^[A-Za-z0-9._:\-]{1,128}$`)
+
+// LimitesRequisicoes define quantas requisições cada cliente pode fazer por janela em
+// cada grupo de rotas, um limite zero desabilita o limite do grupo. O CabecalhoCliente
+// vazio identifica os clientes apenas pelo ip
+type LimitesRequisicoes struct {
+	Janela           time.Duration
+	Leitura          int
+	Escrita          int
+	Lote             int
+	CabecalhoCliente string
+}
+
+func (l LimitesRequisicoes) limite(grupo string) int {
+	switch grupo {
+	case GrupoLeitura:
+		return l.Leitura
+	case GrupoLote:
+		return l.Lote
+	default:
+		return l.Escrita
+	}
+}
+
+func grupoRequisicao(c *gin.Context) string {
+	if rotasLote[c.FullPath()] {
+		return GrupoLote
+	}
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		return GrupoLeitura
+	}
+	return GrupoEscrita
+}
+
+// identifica o cliente pelo cabeçalho configurado ou, na ausência dele, pelo ip. O cabeçalho é
+// enviado pelo próprio cliente e só é usado nas requisições recebidas por um proxy confiável, que
+// deve autenticar o cliente e definir o cabeçalho, caso contrário bastaria alterá-lo para obter um
+// novo limite. O ip é diferente do ip da conexão apenas quando o X-Forwarded-For de um proxy confiável é aceito
+func chaveCliente(c *gin.Context, cabecalho string) string {
+	if cabecalho != "" && c.ClientIP() != c.RemoteIP() {
+		if cliente := c.GetHeader(cabecalho); regexCliente.MatchString(cliente) {
+			return "cliente:" + cliente
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func segundos(d time.Duration) string {
+	return fmt.Sprint(int(math.Ceil(d.Seconds())))
+}
+
+// LimiteMiddleware aplica o limite de requisições do grupo da rota, respondendo 429 com o
+// cabeçalho Retry-After ao exceder o limite. Os cabeçalhos RateLimit-Limit, RateLimit-Remaining
+// e RateLimit-Reset são enviados em todas as respostas limitadas. Erros do limitador não
+// bloqueiam a requisição
+func LimiteMiddleware(limitador limite.Limitador, limites LimitesRequisicoes, logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		grupo := grupoRequisicao(c)
+		maximo := limites.limite(grupo)
+		if maximo <= 0 {
+			c.Next()
+			return
+		}
+		chave := grupo + ":" + chaveCliente(c, limites.CabecalhoCliente)
+		resultado, err := limitador.Consumir(c.Request.Context(), chave, maximo, limites.Janela)
+		if err != nil {
+			rastreamento.Logger(c.Request.Context(), logger).Warn("erro ao consultar limite de requisições", zap.Error(err))
+			c.Next()
+			return
+		}
+		c.Header("RateLimit-Limit", fmt.Sprint(resultado.Limite))
+		c.Header("RateLimit-Remaining", fmt.Sprint(resultado.Restante))
+		c.Header("RateLimit-Reset", segundos(resultado.Reset))
+		if !resultado.Permitido {
+			metricas.RequisicoesLimitadas.WithLabelValues(grupo).Inc()
+			c.Header("Retry-After", segundos(max(resultado.TentarApos, time.Second)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, ErrorResponse{
+				Code:    http.StatusTooManyRequests,
+				Message: "limite de requisições excedido",
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/limite"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// router com uma requisição de leitura por janela, os proxies confiáveis são configurados como no NewRouter
+func routerLimitado(t *testing.T, cabecalho string, proxies ...string) *gin.Engine {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	assert.NoError(t, router.SetTrustedProxies(proxies))
+	router.Use(LimiteMiddleware(limite.NewMemoria(), LimitesRequisicoes{Janela: time.Minute, Leitura: 1, CabecalhoCliente: cabecalho}, zap.NewNop()))
+	router.GET("/recebedores", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func requisitarLimitado(router *gin.Engine, origem, encaminhado, cliente string) int {
+	req := httptest.NewRequest(http.MethodGet, "/recebedores", nil)
+	req.RemoteAddr = origem + ":40000"
+	if encaminhado != "" {
+		req.Header.Set("X-Forwarded-For", encaminhado)
+	}
+	if cliente != "" {
+		req.Header.Set("X-Client-Id", cliente)
+	}
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	return resp.Code
+}
+
+func TestLimiteMiddleware_CabecalhoNaoRenovaLimite(t *testing.T) {
+	router := routerLimitado(t, "")
+	assert.Equal(t, http.StatusOK, requisitarLimitado(router, "203.0.113.7", "", "cliente-a"))
+	//sem o cabeçalho configurado, trocar o identificador ou o X-Forwarded-For não cria um novo limite
+	assert.Equal(t, http.StatusTooManyRequests, requisitarLimitado(router, "203.0.113.7", "", "cliente-b"))
+	assert.Equal(t, http.StatusTooManyRequests, requisitarLimitado(router, "203.0.113.7", "198.51.100.1", "cliente-c"))
+}
+
+func TestLimiteMiddleware_CabecalhoSemProxyConfiavel(t *testing.T) {
+	router := routerLimitado(t, "X-Client-Id")
+	assert.Equal(t, http.StatusOK, requisitarLimitado(router, "203.0.113.7", "", "cliente-a"))
+	//o cabeçalho enviado diretamente pelo cliente é ignorado
+	assert.Equal(t, http.StatusTooManyRequests, requisitarLimitado(router, "203.0.113.7", "", "cliente-b"))
+	assert.Equal(t, http.StatusTooManyRequests, requisitarLimitado(router, "203.0.113.7", "198.51.100.1", "cliente-c"))
+}
+
+func TestLimiteMiddleware_CabecalhoDoProxyConfiavel(t *testing.T) {
+	router := routerLimitado(t, "X-Client-Id", "10.0.0.1")
+	//o gateway autenticado define o cabeçalho, cada cliente possui o próprio limite
+	assert.Equal(t, http.StatusOK, requisitarLimitado(router, "10.0.0.1", "198.51.100.1", "cliente-a"))
+	assert.Equal(t, http.StatusOK, requisitarLimitado(router, "10.0.0.1", "198.51.100.1", "cliente-b"))
+	assert.Equal(t, http.StatusTooManyRequests, requisitarLimitado(router, "10.0.0.1", "198.51.100.1", "cliente-a"))
+	//sem o cabeçalho o cliente é identificado pelo ip informado pelo proxy
+	assert.Equal(t, http.StatusOK, requisitarLimitado(router, "10.0.0.1", "198.51.100.2", ""))
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/gin-gonic/gin"
+)
+
+// registra a quantidade e a latência das requisições por rota e status,
+// e os erros retornados pelos serviços
+func MetricasMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		inicio := time.Now()
+		c.Next()
+		rota := c.FullPath()
+		if rota == "" {
+			//rotas inexistentes não usam o caminho como label para limitar a cardinalidade
+			rota = "nao_encontrada"
+		}
+		status := strconv.Itoa(c.Writer.Status())
+		metricas.RequisicoesHttp.WithLabelValues(c.Request.Method, rota, status).Inc()
+		metricas.DuracaoHttp.WithLabelValues(c.Request.Method, rota, status).Observe(time.Since(inicio).Seconds())
+		if len(c.Errors) > 0 {
+			metricas.RegistrarErro(c.Errors.Last().Err)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// documento OpenAPI das rotas registradas em NewRouter, toda rota nova deve ser descrita nele
+//
+//go:embed openapi.json
+var documentoOpenApi []byte
+
+// página do Swagger UI, os arquivos do Swagger UI são carregados do CDN
+const paginaDocs = `<!DOCTYPE html>
+<html lang="pt-BR">
+<head>
+	<meta charset="utf-8">
+	<title>Transfeera - API de recebedores</title>
+	<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5.17.14/swagger-ui.css">
+</head>
+<body>
+	<div id="swagger-ui"></div>
+	<script src="https://unpkg.com/swagger-ui-dist@5.17.14/swagger-ui-bundle.js"></script>
+	<script>
+		window.ui = SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui"});
+	</script>
+</body>
+</html>`
+
+func OpenApi(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json; charset=utf-8", documentoOpenApi)
+}
+
+func Docs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(paginaDocs))
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/limite"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type documento struct {
+	OpenApi string                                `json:"openapi"`
+	Paths   map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+func carregarDocumento(t *testing.T) documento {
+	var doc documento
+	require.NoError(t, json.Unmarshal(documentoOpenApi, &doc))
+	return doc
+}
+
+// router com todas as opções que registram rotas
+func routerCompleto() *gin.Engine {
+	gin.SetMode(gin.ReleaseMode)
+	return NewRouter(nil, zap.NewNop(),
+		ComLimiteRequisicoes(limite.NewMemoria(), LimitesRequisicoes{Janela: time.Minute}),
+		ComWebhooks(nil),
+		ComSuspeitos(nil),
+		ComTriagem(nil),
+		ComVerificacaoEmail(nil),
+		ComProntidao(time.Second),
+	)
+}
+
+var regexParametro = regexp.MustCompile(`:(\w+)`)
+
+func TestOpenApi_DescreveTodasAsRotas(t *testing.T) {
+	doc := carregarDocumento(t)
+	assert.Equal(t, "3.1.0", doc.OpenApi)
+
+	registradas := map[string]bool{}
+	for _, rota := range routerCompleto().Routes() {
+		caminho := regexParametro.ReplaceAllString(rota.Path, "{$1}")
+		metodo := strings.ToLower(rota.Method)
+		registradas[metodo+" "+caminho] = true
+		_, ok := doc.Paths[caminho][metodo]
+		assert.True(t, ok, "rota %s %s sem descrição no openapi.json", rota.Method, caminho)
+	}
+	//operações descritas que não existem no router
+	for caminho, operacoes := range doc.Paths {
+		for metodo := range operacoes {
+			if metodo == "parameters" {
+				continue
+			}
+			assert.True(t, registradas[metodo+" "+caminho], "operação %s %s do openapi.json não está registrada", metodo, caminho)
+		}
+	}
+}
+
+func TestOpenApi_ReferenciasExistem(t *testing.T) {
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(documentoOpenApi, &doc))
+	componentes := doc["components"].(map[string]any)
+	for _, ref := range regexp.MustCompile(`"\$ref": "#/components/(\w+)/([\w-]+)"`).FindAllStringSubmatch(string(documentoOpenApi), -1) {
+		secao, _ := componentes[ref[1]].(map[string]any)
+		_, ok := secao[ref[2]]
+		assert.True(t, ok, "referência inexistente: %s/%s", ref[1], ref[2])
+	}
+}
+
+func TestOpenApi_Rotas(t *testing.T) {
+	router := routerCompleto()
+
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/json; charset=utf-8", resp.Header().Get("Content-Type"))
+	assert.Equal(t, documentoOpenApi, resp.Body.Bytes())
+
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `url: "/openapi.json"`)
+}
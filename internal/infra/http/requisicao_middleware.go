@@ -0,0 +1,73 @@
+package http
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const cabecalhoRequestId = "X-Request-Id"
+
+// identificadores recebidos fora do formato são substituídos para não poluir os logs
+var regexRequestId = regexp.MustCompile(`^[A-Za-z0-9._:\-]{1,128}$`)
+
+// identifica a requisição pelo cabeçalho X-Request-Id recebido ou por um novo uuid,
+// devolvido na resposta, e associa ao contexto da requisição um logger com o request_id
+func RequestIdMiddleware(logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(cabecalhoRequestId)
+		if !regexRequestId.MatchString(id) {
+			id = uuid.NewString()
+		}
+		c.Set("request_id", id)
+		c.Header(cabecalhoRequestId, id)
+		ctx := rastreamento.ComLogger(c.Request.Context(), logger.With(zap.String("request_id", id)))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// registra um log de acesso por requisição com a rota, o status e a latência,
+// o caminho não é registrado pois pode conter dados pessoais (nome, chave pix)
+func AccessLogMiddleware(logger *zap.Logger) gin.HandlerFunc {
+	ignoradas := map[string]bool{}
+	for _, rota := range rotasSaude {
+		ignoradas[rota] = true
+	}
+	return func(c *gin.Context) {
+		inicio := time.Now()
+		c.Next()
+		if ignoradas[c.Request.URL.Path] {
+			return
+		}
+		rota := c.FullPath()
+		if rota == "" {
+			rota = "nao_encontrada"
+		}
+		status := c.Writer.Status()
+		campos := []zap.Field{
+			zap.String("metodo", c.Request.Method),
+			zap.String("rota", rota),
+			zap.Int("status", status),
+			zap.Duration("latencia", time.Since(inicio)),
+			zap.String("ip", c.ClientIP()),
+			zap.Int("tamanho_resposta", max(c.Writer.Size(), 0)),
+		}
+		if len(c.Errors) > 0 {
+			campos = append(campos, zap.Error(c.Errors.Last().Err))
+		}
+		log := rastreamento.Logger(c.Request.Context(), logger)
+		switch {
+		case status >= 500:
+			log.Error("requisição", campos...)
+		case status >= 400:
+			log.Warn("requisição", campos...)
+		default:
+			log.Info("requisição", campos...)
+		}
+	}
+}
@@ -1,30 +1,151 @@
 package http
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/limite"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.uber.org/zap"
 )
 
-func NewRouter(service *app.RecebedorService, logger *zap.Logger) *gin.Engine {
-	router := gin.Default()
+// configuração opcional do router, as opções são aplicadas antes do registro das rotas de
+// recebedores, então middlewares adicionados ao grupo v1 valem para as rotas registradas depois
+type OpcaoRouter func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger)
+
+// registra as rotas de inscrição e reenvio de webhooks
+func ComWebhooks(service *app.WebhookService) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		handler := &WebhookHandler{service: service, logger: logger}
+		v1.POST("/webhooks", handler.CriarWebhook)
+		v1.GET("/webhooks", handler.ListarWebhooks)
+		v1.DELETE("/webhooks/:id", handler.DeletarWebhook)
+		v1.GET("/webhooks/entregas/falhas", handler.ListarEntregasComFalha)
+		v1.POST("/webhooks/entregas/:id/reenviar", handler.ReenviarEntrega)
+	}
+}
+
+// registra a rota de consulta dos recebedores suspeitos
+func ComSuspeitos(service *app.SuspeitoService) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		handler := &SuspeitoHandler{service: service, logger: logger}
+		v1.GET("/recebedores/suspeitos", handler.BuscarSuspeitos)
+	}
+}
+
+// registra as rotas de consulta e revisão das ocorrências da triagem de listas restritivas
+func ComTriagem(service *app.TriagemService) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		handler := &TriagemHandler{service: service, logger: logger}
+		v1.GET("/triagem/ocorrencias", handler.ListarOcorrencias)
+		v1.POST("/triagem/ocorrencias/:id/revisar", handler.RevisarOcorrencia)
+		v1.GET("/recebedores/:id/ocorrencias", handler.BuscarOcorrenciasPorRecebedor)
+	}
+}
+
+// registra a rota de verificação do email pelo link enviado ao recebedor
+func ComVerificacaoEmail(service *app.VerificacaoEmailService) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		handler := &VerificacaoEmailHandler{service: service, logger: logger}
+		v1.GET("/recebedores/verificar-email", handler.VerificarEmail)
+	}
+}
+
+// limita as requisições de cada cliente por grupo de rotas (leitura, escrita e lote), deve ser
+// a primeira opção para valer também para as rotas registradas pelas demais opções
+func ComLimiteRequisicoes(limitador limite.Limitador, limites LimitesRequisicoes) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		v1.Use(LimiteMiddleware(limitador, limites, logger))
+	}
+}
+
+// define os proxies cujo X-Forwarded-For identifica o ip do cliente, sem a opção o ip da conexão é
+// usado e o cabeçalho enviado pelo cliente é ignorado
+func ComProxiesConfiaveis(proxies []string) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		confiaveis := make([]string, len(proxies))
+		for i, proxy := range proxies {
+			confiaveis[i] = strings.TrimSpace(proxy)
+		}
+		//os proxies são validados na configuração
+		if err := router.SetTrustedProxies(confiaveis); err != nil {
+			logger.Error("configurando proxies confiáveis", zap.Error(err))
+		}
+	}
+}
+
+// registra a rota /readyz com as verificações das dependências da aplicação,
+// sem a opção a rota responde apenas se o processo está no ar
+func ComProntidao(timeout time.Duration, verificacoes ...Verificacao) OpcaoRouter {
+	return func(router *gin.Engine, v1 *gin.RouterGroup, logger *zap.Logger) {
+		handler := &SaudeHandler{verificacoes: verificacoes, timeout: timeout, logger: logger}
+		router.GET("/readyz", handler.Readyz)
+	}
+}
+
+func NewRouter(service *app.RecebedorService, logger *zap.Logger, opcoes ...OpcaoRouter) *gin.Engine {
+	router := gin.New()
+	//por padrão o gin aceita o X-Forwarded-For de qualquer origem
+	router.SetTrustedProxies(nil)
+	//o log de acesso fica após o otelgin para registrar o trace_id da requisição
+	router.Use(RequestIdMiddleware(logger), gin.Recovery(), MetricasMiddleware(),
+		otelgin.Middleware(rastreamento.NomeServico, otelgin.WithFilter(semRastreamento)), AccessLogMiddleware(logger))
+	saude := &SaudeHandler{timeout: timeoutVerificacaoPadrao, logger: logger}
+	router.GET("/healthz", saude.Healthz)
+	router.GET("/version", saude.Version)
+	router.GET("/metrics", gin.WrapH(metricas.Handler()))
+	router.GET("/openapi.json", OpenApi)
+	router.GET("/docs", Docs)
 	handler := &RecebedorHandler{service: service, logger: logger}
 	router.Use(ErrorHandler())
 	v1 := router.Group("/api/v1")
+	for _, opcao := range opcoes {
+		opcao(router, v1, logger)
+	}
 	{
 		v1.GET("/recebedores/id/:id", handler.BuscarRecebedorPorId)
 		v1.GET("/recebedores/nome/:nome", handler.BuscarRecebedorPorNome)
 		v1.GET("/recebedores/status/:status", handler.BuscarRecebedorPorStatus)
 		v1.GET("/recebedores/chave", handler.BuscarRecebedorPorChave)
 		v1.GET("/recebedores/tipoChave/:tipoChave", handler.BuscarRecebedorPorTipoChave)
+		v1.GET("/recebedores/:id/brcode", handler.GerarBrCode)
 		v1.POST("/recebedores", handler.CriarRecebedor)
+		v1.POST("/recebedores/brcode", handler.CriarRecebedorPorBrCode)
 		v1.PATCH("/recebedores", handler.EditarRecebedor)
 		v1.PATCH("/recebedores/:id", handler.EditarEmailRecebedor)
+		v1.PATCH("/recebedores/:id/validar", handler.ValidarRecebedor)
 		v1.DELETE("/recebedores/:id", handler.DeletarRecebedor)
 		v1.DELETE("/recebedores/deletar", handler.DeletarRecebedores)
 
 	}
+	if !possuiRota(router, "/readyz") {
+		router.GET("/readyz", saude.Readyz)
+	}
 
 	return router
 
 }
+
+func possuiRota(router *gin.Engine, caminho string) bool {
+	for _, rota := range router.Routes() {
+		if rota.Path == caminho {
+			return true
+		}
+	}
+	return false
+}
+
+// as rotas de saúde e de métricas não geram traces
+func semRastreamento(r *http.Request) bool {
+	for _, rota := range rotasSaude {
+		if r.URL.Path == rota {
+			return false
+		}
+	}
+	return true
+}
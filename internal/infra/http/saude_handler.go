@@ -0,0 +1,60 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/versao"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const timeoutVerificacaoPadrao = 2 * time.Second
+
+// rotas de saúde e de métricas, fora do grupo /api/v1 e sem log de acesso
+var rotasSaude = []string{"/healthz", "/readyz", "/version", "/metrics"}
+
+// Verificacao de prontidão de uma dependência, retorna erro se a dependência não está pronta
+type Verificacao struct {
+	Nome      string
+	Verificar func(ctx context.Context) error
+}
+
+type SaudeHandler struct {
+	verificacoes []Verificacao
+	timeout      time.Duration
+	logger       *zap.Logger
+}
+
+// o processo está no ar
+func (h *SaudeHandler) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// a aplicação está pronta para receber requisições se todas as verificações passarem dentro do timeout
+func (h *SaudeHandler) Readyz(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+	status := http.StatusOK
+	resultados := gin.H{}
+	for _, verificacao := range h.verificacoes {
+		if err := verificacao.Verificar(ctx); err != nil {
+			h.logger.Warn("verificação de prontidão falhou", zap.String("verificacao", verificacao.Nome), zap.Error(err))
+			status = http.StatusServiceUnavailable
+			resultados[verificacao.Nome] = err.Error()
+			continue
+		}
+		resultados[verificacao.Nome] = "ok"
+	}
+	situacao := "ok"
+	if status != http.StatusOK {
+		situacao = "indisponivel"
+	}
+	c.JSON(status, gin.H{"status": situacao, "verificacoes": resultados})
+}
+
+// informações do build em execução
+func (h *SaudeHandler) Version(c *gin.Context) {
+	c.JSON(http.StatusOK, versao.Obter())
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type SuspeitoHandler struct {
+	service *app.SuspeitoService
+	logger  *zap.Logger
+}
+
+// retorna os recebedores suspeitos da última detecção, opcionalmente a partir de uma pontuação mínima
+func (h *SuspeitoHandler) BuscarSuspeitos(c *gin.Context) {
+	pontuacaoMinima, err := strconv.Atoi(c.DefaultQuery("pontuacao_minima", "0"))
+	if err != nil || pontuacaoMinima < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "parâmetro de pontuação mínima inválido"})
+		return
+	}
+	relatorio, err := h.service.BuscarSuspeitos(c.Request.Context(), pontuacaoMinima)
+	if err != nil {
+		rastreamento.Logger(c.Request.Context(), h.logger).Error("buscando recebedores suspeitos", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, relatorio)
+}
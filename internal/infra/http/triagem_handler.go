@@ -0,0 +1,77 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type TriagemHandler struct {
+	service *app.TriagemService
+	logger  *zap.Logger
+}
+
+// corpo da revisão de uma ocorrência, a decisão é Confirmada ou Liberada
+type RevisaoOcorrencia struct {
+	Decisao    domain.StatusOcorrencia `json:"decisao"`
+	Observacao string                  `json:"observacao"`
+}
+
+// retorna as ocorrências da triagem, opcionalmente filtradas pelo status
+func (h *TriagemHandler) ListarOcorrencias(c *gin.Context) {
+	ocorrencias, err := h.service.ListarOcorrencias(c.Request.Context(), domain.StatusOcorrencia(c.Query("status")))
+	if err != nil {
+		rastreamento.Logger(c.Request.Context(), h.logger).Error("consultando ocorrências da triagem", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, ocorrencias)
+}
+
+func (h *TriagemHandler) BuscarOcorrenciasPorRecebedor(c *gin.Context) {
+	idTmp, err := strconv.Atoi(c.Param("id"))
+	if err != nil || idTmp < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "id inválido",
+		})
+		return
+	}
+	ocorrencias, err := h.service.BuscarOcorrenciasPorRecebedor(c.Request.Context(), uint(idTmp))
+	if err != nil {
+		rastreamento.Logger(c.Request.Context(), h.logger).Error("consultando ocorrências do recebedor", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, ocorrencias)
+}
+
+// confirma ou libera a ocorrência, a liberação da última restrição desbloqueia o recebedor
+func (h *TriagemHandler) RevisarOcorrencia(c *gin.Context) {
+	idTmp, err := strconv.Atoi(c.Param("id"))
+	if err != nil || idTmp < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "id inválido",
+		})
+		return
+	}
+	var revisao RevisaoOcorrencia
+	if err := c.ShouldBindJSON(&revisao); err != nil {
+		rastreamento.Logger(c.Request.Context(), h.logger).Error("Binding json", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	ocorrencia, err := h.service.RevisarOcorrencia(c.Request.Context(), uint(idTmp), revisao.Decisao, revisao.Observacao)
+	if err != nil {
+		rastreamento.Logger(c.Request.Context(), h.logger).Error("revisando ocorrência", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, ocorrencia)
+}
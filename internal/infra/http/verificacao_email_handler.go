@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/rastreamento"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type VerificacaoEmailHandler struct {
+	service *app.VerificacaoEmailService
+	logger  *zap.Logger
+}
+
+// marca o email do recebedor como verificado pelo token enviado no link de verificação
+func (h *VerificacaoEmailHandler) VerificarEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "token não informado",
+		})
+		return
+	}
+	recebedor, err := h.service.Verificar(c.Request.Context(), token)
+	if err != nil {
+		rastreamento.Logger(c.Request.Context(), h.logger).Info("verificando email do recebedor", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, recebedor)
+}
@@ -0,0 +1,97 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/app"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+type WebhookHandler struct {
+	service *app.WebhookService
+	logger  *zap.Logger
+}
+
+func (h *WebhookHandler) CriarWebhook(c *gin.Context) {
+	var webhook domain.Webhook
+	if err := c.ShouldBindJSON(&webhook); err != nil {
+		h.logger.Error("Binding json", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	validate := validator.New()
+	if err := validate.Struct(&webhook); err != nil {
+		h.logger.Error("validação de campos", zap.Error(err))
+		campos := formatarErroCampos(err.(validator.ValidationErrors))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"message": "campos obrigatórios",
+			"campos":  campos,
+		})
+		return
+	}
+	if err := h.service.CriarWebhook(c.Request.Context(), &webhook); err != nil {
+		h.logger.Error("criando webhook", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	//o segredo é retornado apenas na criação do webhook
+	c.JSON(http.StatusCreated, webhook)
+}
+
+func (h *WebhookHandler) ListarWebhooks(c *gin.Context) {
+	webhooks, err := h.service.ListarWebhooks(c.Request.Context())
+	if err != nil {
+		h.logger.Error("consultando webhooks", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, webhooks)
+}
+
+func (h *WebhookHandler) DeletarWebhook(c *gin.Context) {
+	idTmp, err := strconv.Atoi(c.Param("id"))
+	if err != nil || idTmp < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "id inválido",
+		})
+		return
+	}
+	if err := h.service.DeletarWebhook(c.Request.Context(), uint(idTmp)); err != nil {
+		h.logger.Error("deletando webhook", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func (h *WebhookHandler) ListarEntregasComFalha(c *gin.Context) {
+	entregas, err := h.service.ListarEntregasComFalha(c.Request.Context())
+	if err != nil {
+		h.logger.Error("consultando entregas com falha", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, entregas)
+}
+
+func (h *WebhookHandler) ReenviarEntrega(c *gin.Context) {
+	idTmp, err := strconv.Atoi(c.Param("id"))
+	if err != nil || idTmp < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"status":  http.StatusBadRequest,
+			"message": "id inválido",
+		})
+		return
+	}
+	if err := h.service.ReenviarEntrega(c.Request.Context(), uint(idTmp)); err != nil {
+		h.logger.Error("reenviando entrega de webhook", zap.Error(err))
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusAccepted)
+}
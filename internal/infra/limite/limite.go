@@ -0,0 +1,95 @@
+// Package limite implementa o limite de requisições por cliente com o algoritmo token bucket:
+// cada chave possui um balde com capacidade para limite requisições, reabastecido continuamente
+// à taxa de limite requisições por janela
+package limite
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limitador consome um token do balde da chave
+type Limitador interface {
+	Consumir(ctx context.Context, chave string, limite int, janela time.Duration) (Resultado, error)
+}
+
+type Resultado struct {
+	Permitido bool
+	Limite    int
+	//tokens inteiros restantes no balde
+	Restante int
+	//tempo até o balde estar cheio novamente
+	Reset time.Duration
+	//tempo até haver um token disponível, zero se a requisição foi permitida
+	TentarApos time.Duration
+}
+
+func taxa(limite int, janela time.Duration) float64 {
+	return float64(limite) / janela.Seconds()
+}
+
+// NovoResultado calcula o resultado a partir dos tokens restantes após o consumo
+func NovoResultado(permitido bool, tokens float64, limite int, janela time.Duration) Resultado {
+	porSegundo := taxa(limite, janela)
+	resultado := Resultado{
+		Permitido: permitido,
+		Limite:    limite,
+		Restante:  int(math.Max(0, math.Floor(tokens))),
+		Reset:     time.Duration((float64(limite) - tokens) / porSegundo * float64(time.Second)),
+	}
+	if !permitido {
+		resultado.TentarApos = time.Duration((1 - tokens) / porSegundo * float64(time.Second))
+	}
+	return resultado
+}
+
+type balde struct {
+	tokens       float64
+	atualizadoEm time.Time
+}
+
+// Memoria mantém os baldes no processo, cada réplica possui os próprios limites
+type Memoria struct {
+	mu            sync.Mutex
+	baldes        map[string]*balde
+	ultimaLimpeza time.Time
+	agora         func() time.Time
+}
+
+func NewMemoria() *Memoria {
+	return &Memoria{baldes: map[string]*balde{}, agora: time.Now, ultimaLimpeza: time.Now()}
+}
+
+func (m *Memoria) Consumir(ctx context.Context, chave string, limite int, janela time.Duration) (Resultado, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	agora := m.agora()
+	m.limpar(agora, janela)
+	b, ok := m.baldes[chave]
+	if !ok {
+		b = &balde{tokens: float64(limite), atualizadoEm: agora}
+		m.baldes[chave] = b
+	}
+	b.tokens = math.Min(float64(limite), b.tokens+agora.Sub(b.atualizadoEm).Seconds()*taxa(limite, janela))
+	b.atualizadoEm = agora
+	permitido := b.tokens >= 1
+	if permitido {
+		b.tokens--
+	}
+	return NovoResultado(permitido, b.tokens, limite, janela), nil
+}
+
+// descarta a cada janela os baldes sem uso há mais de uma janela, que já estariam cheios
+func (m *Memoria) limpar(agora time.Time, janela time.Duration) {
+	if agora.Sub(m.ultimaLimpeza) < janela {
+		return
+	}
+	for chave, b := range m.baldes {
+		if agora.Sub(b.atualizadoEm) >= janela {
+			delete(m.baldes, chave)
+		}
+	}
+	m.ultimaLimpeza = agora
+}
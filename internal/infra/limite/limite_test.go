@@ -0,0 +1,51 @@
+package limite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoria_Consumir(t *testing.T) {
+	ctx := context.Background()
+	agora := time.Now()
+	memoria := NewMemoria()
+	memoria.agora = func() time.Time { return agora }
+
+	for i := 2; i >= 0; i-- {
+		resultado, err := memoria.Consumir(ctx, "ip:10.0.0.1", 3, time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, resultado.Permitido)
+		assert.Equal(t, i, resultado.Restante)
+	}
+
+	resultado, _ := memoria.Consumir(ctx, "ip:10.0.0.1", 3, time.Minute)
+	assert.False(t, resultado.Permitido)
+	assert.Equal(t, 20*time.Second, resultado.TentarApos)
+	assert.Equal(t, time.Minute, resultado.Reset)
+
+	//outra chave possui o próprio balde
+	resultado, _ = memoria.Consumir(ctx, "ip:10.0.0.2", 3, time.Minute)
+	assert.True(t, resultado.Permitido)
+
+	//um token é reabastecido a cada 20 segundos
+	agora = agora.Add(20 * time.Second)
+	resultado, _ = memoria.Consumir(ctx, "ip:10.0.0.1", 3, time.Minute)
+	assert.True(t, resultado.Permitido)
+	resultado, _ = memoria.Consumir(ctx, "ip:10.0.0.1", 3, time.Minute)
+	assert.False(t, resultado.Permitido)
+}
+
+func TestMemoria_DescartaBaldesInativos(t *testing.T) {
+	ctx := context.Background()
+	agora := time.Now()
+	memoria := NewMemoria()
+	memoria.agora = func() time.Time { return agora }
+	memoria.Consumir(ctx, "ip:10.0.0.1", 3, time.Minute)
+
+	agora = agora.Add(2 * time.Minute)
+	memoria.Consumir(ctx, "ip:10.0.0.2", 3, time.Minute)
+	assert.Len(t, memoria.baldes, 1)
+}
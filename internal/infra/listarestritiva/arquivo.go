@@ -0,0 +1,135 @@
+// Package listarestritiva carrega as listas restritivas usadas na triagem dos recebedores a
+// partir de arquivos CSV ou JSON
+package listarestritiva
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// Arquivos lê as listas dos arquivos informados a cada carga, permitindo atualizar as listas
+// sem reiniciar a aplicação. O formato é definido pela extensão (.csv ou .json)
+type Arquivos struct {
+	caminhos []string
+}
+
+// os espaços em volta dos caminhos são removidos e os caminhos vazios ignorados
+func NewArquivos(caminhos ...string) *Arquivos {
+	a := &Arquivos{}
+	for _, caminho := range caminhos {
+		if caminho = strings.TrimSpace(caminho); caminho != "" {
+			a.caminhos = append(a.caminhos, caminho)
+		}
+	}
+	return a
+}
+
+func (a *Arquivos) Carregar(ctx context.Context) ([]domain.ListaRestritiva, error) {
+	listas := make([]domain.ListaRestritiva, 0, len(a.caminhos))
+	for _, caminho := range a.caminhos {
+		lista, err := lerArquivo(caminho)
+		if err != nil {
+			return nil, fmt.Errorf("lista restritiva %s: %w", caminho, err)
+		}
+		listas = append(listas, lista)
+	}
+	return listas, nil
+}
+
+// o nome da lista é o nome do arquivo sem a extensão, exceto se informado no JSON
+func lerArquivo(caminho string) (domain.ListaRestritiva, error) {
+	arquivo, err := os.Open(caminho)
+	if err != nil {
+		return domain.ListaRestritiva{}, err
+	}
+	defer arquivo.Close()
+	extensao := strings.ToLower(filepath.Ext(caminho))
+	nome := strings.TrimSuffix(filepath.Base(caminho), filepath.Ext(caminho))
+	switch extensao {
+	case ".csv":
+		return LerCsv(nome, arquivo)
+	case ".json":
+		return LerJson(nome, arquivo)
+	}
+	return domain.ListaRestritiva{}, fmt.Errorf("formato %q não suportado, use .csv ou .json", extensao)
+}
+
+// LerCsv lê a lista com cabeçalho e as colunas cpf_cnpj, nome e motivo em qualquer ordem,
+// ao menos uma das colunas cpf_cnpj e nome é obrigatória
+func LerCsv(nome string, r io.Reader) (domain.ListaRestritiva, error) {
+	lista := domain.ListaRestritiva{Nome: nome, Entradas: []domain.EntradaListaRestritiva{}}
+	leitor := csv.NewReader(r)
+	leitor.TrimLeadingSpace = true
+	cabecalho, err := leitor.Read()
+	if err != nil {
+		return lista, fmt.Errorf("lendo cabeçalho: %w", err)
+	}
+	indices := map[string]int{}
+	for i, coluna := range cabecalho {
+		indices[strings.ToLower(strings.TrimSpace(coluna))] = i
+	}
+	_, possuiDocumento := indices["cpf_cnpj"]
+	_, possuiNome := indices["nome"]
+	if !possuiDocumento && !possuiNome {
+		return lista, errors.New("o cabeçalho deve possuir a coluna cpf_cnpj ou nome")
+	}
+	valor := func(registro []string, coluna string) string {
+		if i, ok := indices[coluna]; ok && i < len(registro) {
+			return strings.TrimSpace(registro[i])
+		}
+		return ""
+	}
+	for linha := 2; ; linha++ {
+		registro, err := leitor.Read()
+		if errors.Is(err, io.EOF) {
+			return lista, nil
+		}
+		if err != nil {
+			return lista, err
+		}
+		entrada := domain.EntradaListaRestritiva{CpfCnpj: valor(registro, "cpf_cnpj"), Nome: valor(registro, "nome"), Motivo: valor(registro, "motivo")}
+		if entrada.CpfCnpj == "" && entrada.Nome == "" {
+			return lista, fmt.Errorf("linha %d: informe o cpf_cnpj ou o nome", linha)
+		}
+		lista.Entradas = append(lista.Entradas, entrada)
+	}
+}
+
+// LerJson lê a lista no formato {"nome": ..., "entradas": [...]} ou apenas o array de entradas
+func LerJson(nome string, r io.Reader) (domain.ListaRestritiva, error) {
+	conteudo, err := io.ReadAll(r)
+	if err != nil {
+		return domain.ListaRestritiva{}, err
+	}
+	lista := domain.ListaRestritiva{Nome: nome}
+	if bytes.HasPrefix(bytes.TrimSpace(conteudo), []byte("[")) {
+		err = json.Unmarshal(conteudo, &lista.Entradas)
+	} else {
+		err = json.Unmarshal(conteudo, &lista)
+	}
+	if err != nil {
+		return lista, err
+	}
+	if lista.Nome == "" {
+		lista.Nome = nome
+	}
+	if lista.Entradas == nil {
+		lista.Entradas = []domain.EntradaListaRestritiva{}
+	}
+	for i, entrada := range lista.Entradas {
+		if strings.TrimSpace(entrada.CpfCnpj) == "" && strings.TrimSpace(entrada.Nome) == "" {
+			return lista, fmt.Errorf("entrada %d: informe o cpf_cnpj ou o nome", i+1)
+		}
+	}
+	return lista, nil
+}
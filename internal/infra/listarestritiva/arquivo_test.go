@@ -0,0 +1,61 @@
+package listarestritiva
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLerCsv(t *testing.T) {
+	lista, err := LerCsv("ofac", strings.NewReader("nome,motivo,cpf_cnpj\nJoão da Silva,sanção,\n,fraude,783.852.830-56\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "ofac", lista.Nome)
+	assert.Equal(t, []domain.EntradaListaRestritiva{
+		{Nome: "João da Silva", Motivo: "sanção"},
+		{CpfCnpj: "783.852.830-56", Motivo: "fraude"},
+	}, lista.Entradas)
+
+	_, err = LerCsv("ofac", strings.NewReader("motivo\nsanção\n"))
+	assert.EqualError(t, err, "o cabeçalho deve possuir a coluna cpf_cnpj ou nome")
+	_, err = LerCsv("ofac", strings.NewReader("nome,cpf_cnpj\nana,\n,\n"))
+	assert.EqualError(t, err, "linha 3: informe o cpf_cnpj ou o nome")
+}
+
+func TestLerJson(t *testing.T) {
+	lista, err := LerJson("arquivo", strings.NewReader(`{"nome": "interna", "entradas": [{"cpf_cnpj": "80.560.231/0001-99", "motivo": "chargeback"}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "interna", lista.Nome)
+	assert.Equal(t, "80.560.231/0001-99", lista.Entradas[0].CpfCnpj)
+
+	//apenas o array de entradas usa o nome do arquivo
+	lista, err = LerJson("arquivo", strings.NewReader(`[{"nome": "ana"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, "arquivo", lista.Nome)
+	assert.Len(t, lista.Entradas, 1)
+
+	_, err = LerJson("arquivo", strings.NewReader(`[{"motivo": "sem identificação"}]`))
+	assert.EqualError(t, err, "entrada 1: informe o cpf_cnpj ou o nome")
+}
+
+func TestArquivos_Carregar(t *testing.T) {
+	dir := t.TempDir()
+	csv := filepath.Join(dir, "sancoes.csv")
+	json := filepath.Join(dir, "interna.json")
+	require.NoError(t, os.WriteFile(csv, []byte("cpf_cnpj,nome\n783.852.830-56,ana\n"), 0o600))
+	require.NoError(t, os.WriteFile(json, []byte(`[{"nome": "pedro"}]`), 0o600))
+
+	listas, err := NewArquivos(csv, json).Carregar(context.Background())
+	require.NoError(t, err)
+	require.Len(t, listas, 2)
+	assert.Equal(t, "sancoes", listas[0].Nome)
+	assert.Equal(t, "interna", listas[1].Nome)
+
+	_, err = NewArquivos(filepath.Join(dir, "lista.txt")).Carregar(context.Background())
+	assert.Error(t, err)
+}
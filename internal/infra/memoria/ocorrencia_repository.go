@@ -0,0 +1,80 @@
+package memoria
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// ocorrências da triagem de listas restritivas em memória
+type ocorrenciaRepository struct {
+	mu          sync.RWMutex
+	ocorrencias map[uint]domain.OcorrenciaTriagem
+	ultimoId    uint
+}
+
+func NewOcorrenciaRepository() *ocorrenciaRepository {
+	return &ocorrenciaRepository{ocorrencias: map[uint]domain.OcorrenciaTriagem{}}
+}
+
+func (r *ocorrenciaRepository) CriarOcorrencia(ctx context.Context, ocorrencia *domain.OcorrenciaTriagem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ultimoId++
+	ocorrencia.Id = r.ultimoId
+	if ocorrencia.CriadoEm.IsZero() {
+		ocorrencia.CriadoEm = time.Now().UTC()
+	}
+	r.ocorrencias[ocorrencia.Id] = *ocorrencia
+	return nil
+}
+
+func (r *ocorrenciaRepository) BuscarOcorrenciaPorId(ctx context.Context, id uint) (*domain.OcorrenciaTriagem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ocorrencia, ok := r.ocorrencias[id]
+	if !ok {
+		return nil, nil
+	}
+	return &ocorrencia, nil
+}
+
+// ocorrências que atendem o filtro ordenadas pelo id
+func (r *ocorrenciaRepository) filtrar(filtro func(domain.OcorrenciaTriagem) bool) []*domain.OcorrenciaTriagem {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	encontradas := []*domain.OcorrenciaTriagem{}
+	for _, ocorrencia := range r.ocorrencias {
+		if filtro(ocorrencia) {
+			copia := ocorrencia
+			encontradas = append(encontradas, &copia)
+		}
+	}
+	sort.Slice(encontradas, func(i, j int) bool { return encontradas[i].Id < encontradas[j].Id })
+	return encontradas
+}
+
+func (r *ocorrenciaRepository) ListarOcorrencias(ctx context.Context, status domain.StatusOcorrencia) ([]*domain.OcorrenciaTriagem, error) {
+	return r.filtrar(func(o domain.OcorrenciaTriagem) bool { return status == "" || o.Status == status }), nil
+}
+
+func (r *ocorrenciaRepository) BuscarOcorrenciasPorRecebedor(ctx context.Context, recebedorId uint) ([]*domain.OcorrenciaTriagem, error) {
+	return r.filtrar(func(o domain.OcorrenciaTriagem) bool { return o.RecebedorId == recebedorId }), nil
+}
+
+func (r *ocorrenciaRepository) RevisarOcorrencia(ctx context.Context, ocorrencia *domain.OcorrenciaTriagem) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	atual, ok := r.ocorrencias[ocorrencia.Id]
+	if !ok {
+		return nil
+	}
+	atual.Status = ocorrencia.Status
+	atual.Observacao = ocorrencia.Observacao
+	atual.RevisadoEm = ocorrencia.RevisadoEm
+	r.ocorrencias[ocorrencia.Id] = atual
+	return nil
+}
@@ -0,0 +1,14 @@
+package memoria
+
+import (
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/contrato"
+)
+
+func TestOcorrenciaRepository_Contrato(t *testing.T) {
+	contrato.TestarOcorrenciaRepository(t, func(t *testing.T) domain.OcorrenciaRepository {
+		return NewOcorrenciaRepository()
+	})
+}
@@ -0,0 +1,302 @@
+package memoria
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+// repositório de recebedores em memória, com a mesma semântica do repositório do postgres,
+// para testes e demonstrações. Não registra os eventos dos recebedores
+type recebedorRepository struct {
+	mu          sync.RWMutex
+	recebedores map[uint]domain.Recebedor
+	ultimoId    uint
+	//chaves dos recebedores deletados, para o domain.HistoricoChaves
+	deletadas []domain.ChaveDeletada
+}
+
+func NewRecebedorRepository() *recebedorRepository {
+	return &recebedorRepository{recebedores: map[uint]domain.Recebedor{}}
+}
+
+// valor da coluna usada nas buscas por campo
+func valorCampo(recebedor domain.Recebedor, nomeCampo string) (string, error) {
+	switch nomeCampo {
+	case "nome":
+		return recebedor.Nome, nil
+	case "chave_pix":
+		return recebedor.ChavePix, nil
+	case "tipo_chave_pix":
+		return string(recebedor.TipoChavePix), nil
+	case "status_recebedor":
+		return recebedor.Status, nil
+	case "cpf_cnpj":
+		return recebedor.CpfCnpj, nil
+	case "email":
+		return recebedor.Email, nil
+	}
+	return "", fmt.Errorf("campo %s não existe", nomeCampo)
+}
+
+// recebedores que atendem o filtro ordenados pelo id
+func (r *recebedorRepository) filtrar(filtro func(domain.Recebedor) bool) []domain.Recebedor {
+	encontrados := []domain.Recebedor{}
+	for _, recebedor := range r.recebedores {
+		if filtro(recebedor) {
+			encontrados = append(encontrados, recebedor)
+		}
+	}
+	sort.Slice(encontrados, func(i, j int) bool { return encontrados[i].Id < encontrados[j].Id })
+	return encontrados
+}
+
+// aplica LIMIT e OFFSET retornando cópias, os recebedores armazenados não são expostos
+func paginar(recebedores []domain.Recebedor, limite, offset int) []*domain.Recebedor {
+	pagina := []*domain.Recebedor{}
+	for i := offset; i < len(recebedores) && len(pagina) < limite; i++ {
+		recebedor := recebedores[i]
+		pagina = append(pagina, &recebedor)
+	}
+	return pagina
+}
+
+// o campo é validado mesmo sem recebedores, assim como a coluna na query do postgres
+func porCampo(valor, nomeCampo string) (func(domain.Recebedor) bool, error) {
+	if _, err := valorCampo(domain.Recebedor{}, nomeCampo); err != nil {
+		return nil, err
+	}
+	return func(recebedor domain.Recebedor) bool {
+		v, _ := valorCampo(recebedor, nomeCampo)
+		return v == valor
+	}, nil
+}
+
+func porChaves(chaves []string) func(domain.Recebedor) bool {
+	return func(recebedor domain.Recebedor) bool {
+		for _, chave := range chaves {
+			if recebedor.ChavePix == chave {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (r *recebedorRepository) BuscarRecebedorPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	recebedor, ok := r.recebedores[id]
+	if !ok {
+		return nil, nil
+	}
+	return &recebedor, nil
+}
+
+func (r *recebedorRepository) BuscarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string, limite, offset int) ([]*domain.Recebedor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	filtro, err := porCampo(valor, nomeCampo)
+	if err != nil {
+		return nil, err
+	}
+	return paginar(r.filtrar(filtro), limite, offset), nil
+}
+
+func (r *recebedorRepository) ContarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	filtro, err := porCampo(valor, nomeCampo)
+	if err != nil {
+		return 0, err
+	}
+	return len(r.filtrar(filtro)), nil
+}
+
+func (r *recebedorRepository) BuscarRecebedoresPorChaves(ctx context.Context, chaves []string, limite, offset int) ([]*domain.Recebedor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return paginar(r.filtrar(porChaves(chaves)), limite, offset), nil
+}
+
+func (r *recebedorRepository) ContarRecebedoresPorChaves(ctx context.Context, chaves []string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.filtrar(porChaves(chaves))), nil
+}
+
+func (r *recebedorRepository) ContarRecebedoresAgrupados(ctx context.Context) ([]domain.ContagemRecebedores, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	type grupo struct{ status, tipo string }
+	totais := map[grupo]int{}
+	for _, recebedor := range r.recebedores {
+		totais[grupo{recebedor.Status, string(recebedor.TipoChavePix)}]++
+	}
+	contagens := []domain.ContagemRecebedores{}
+	for g, total := range totais {
+		contagens = append(contagens, domain.ContagemRecebedores{Status: g.status, TipoChavePix: g.tipo, Total: total})
+	}
+	return contagens, nil
+}
+
+// tipos aceitos pelo enum tipo_chave_pix_enum do postgres
+func tipoValido(tipo domain.TipoChavePix) bool {
+	switch tipo {
+	case domain.Cpf, domain.Cnpj, domain.Email, domain.Telefone, domain.ChaveAleatoria:
+		return true
+	}
+	return false
+}
+
+func (r *recebedorRepository) CriarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	if !tipoValido(recebedor.TipoChavePix) {
+		return domain.ErrTipoChaveInvalida
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.chaveCadastrada(recebedor.ChavePix, 0) {
+		return domain.ErrChavePixJaCadastrada
+	}
+	r.ultimoId++
+	recebedor.Id = r.ultimoId
+	armazenado := *recebedor
+	//campos de exibição não são colunas da tabela
+	armazenado.ChavePixFormatada = ""
+	armazenado.TipoCorrespondente = ""
+	//o email é verificado apenas pelo VerificarEmailRecebedor
+	armazenado.EmailVerificadoEm = nil
+	r.recebedores[recebedor.Id] = armazenado
+	return nil
+}
+
+// equivale ao índice único da chave pix do postgres, deve ser chamado com o lock obtido
+func (r *recebedorRepository) chaveCadastrada(chave string, ignorarId uint) bool {
+	for id, recebedor := range r.recebedores {
+		if id != ignorarId && recebedor.ChavePix == chave {
+			return true
+		}
+	}
+	return false
+}
+
+// altera o recebedor se ele existir, assim como o UPDATE do postgres nenhum erro é retornado se ele não existir
+func (r *recebedorRepository) alterar(id uint, alteracao func(*domain.Recebedor)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recebedor, ok := r.recebedores[id]
+	if !ok {
+		return
+	}
+	alteracao(&recebedor)
+	r.recebedores[id] = recebedor
+}
+
+func (r *recebedorRepository) EditarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	var err error
+	r.alterar(recebedor.Id, func(atual *domain.Recebedor) {
+		if recebedor.ChavePix != "" && r.chaveCadastrada(recebedor.ChavePix, atual.Id) {
+			err = domain.ErrChavePixJaCadastrada
+			return
+		}
+		if recebedor.CpfCnpj != "" {
+			atual.CpfCnpj = recebedor.CpfCnpj
+		}
+		if recebedor.Nome != "" {
+			atual.Nome = recebedor.Nome
+		}
+		if recebedor.TipoChavePix != "" {
+			atual.TipoChavePix = recebedor.TipoChavePix
+		}
+		if recebedor.ChavePix != "" {
+			atual.ChavePix = recebedor.ChavePix
+		}
+		if recebedor.Email != "" {
+			alterarEmail(atual, recebedor.Email)
+		}
+	})
+	return err
+}
+
+// o novo email precisa ser verificado novamente
+func alterarEmail(recebedor *domain.Recebedor, email string) {
+	if recebedor.Email != email {
+		recebedor.EmailVerificadoEm = nil
+	}
+	recebedor.Email = email
+}
+
+func (r *recebedorRepository) EditarEmailRecebedor(ctx context.Context, id uint, email string) error {
+	r.alterar(id, func(atual *domain.Recebedor) { alterarEmail(atual, email) })
+	return nil
+}
+
+func (r *recebedorRepository) VerificarEmailRecebedor(ctx context.Context, id uint, email string, verificadoEm time.Time) (bool, error) {
+	verificado := false
+	r.alterar(id, func(atual *domain.Recebedor) {
+		if atual.Email == email && atual.EmailVerificadoEm == nil {
+			atual.EmailVerificadoEm = &verificadoEm
+			verificado = true
+		}
+	})
+	return verificado, nil
+}
+
+func (r *recebedorRepository) EditarStatusRecebedor(ctx context.Context, id uint, status string) error {
+	r.alterar(id, func(atual *domain.Recebedor) { atual.Status = status })
+	return nil
+}
+
+// Deprecated: não utilizar
+func (r *recebedorRepository) DeletarRecebedores(ctx context.Context, ids []uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		r.deletar(id)
+	}
+	return nil
+}
+
+func (r *recebedorRepository) DeletarRecebedor(ctx context.Context, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deletar(id)
+	return nil
+}
+
+// remove o recebedor registrando a chave deletada, deve ser chamado com o lock obtido
+func (r *recebedorRepository) deletar(id uint) {
+	recebedor, ok := r.recebedores[id]
+	if !ok {
+		return
+	}
+	delete(r.recebedores, id)
+	r.deletadas = append(r.deletadas, domain.ChaveDeletada{
+		ChavePix:    recebedor.ChavePix,
+		RecebedorId: recebedor.Id,
+		CpfCnpj:     recebedor.CpfCnpj,
+		Nome:        recebedor.Nome,
+		DeletadoEm:  time.Now().UTC(),
+	})
+}
+
+func (r *recebedorRepository) BuscarChavesDeletadas(ctx context.Context) ([]domain.ChaveDeletada, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]domain.ChaveDeletada{}, r.deletadas...), nil
+}
+
+func (r *recebedorRepository) BuscarChave(ctx context.Context, chave string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, recebedor := range r.recebedores {
+		if recebedor.ChavePix == chave {
+			return chave, nil
+		}
+	}
+	return "", nil
+}
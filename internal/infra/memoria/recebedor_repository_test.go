@@ -0,0 +1,33 @@
+package memoria
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/contrato"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecebedorRepository_Contrato(t *testing.T) {
+	contrato.TestarRecebedorRepository(t, func(t *testing.T) domain.RecebedorRepository {
+		return NewRecebedorRepository()
+	})
+}
+
+func TestRecebedorRepository_CampoInexistente(t *testing.T) {
+	_, err := NewRecebedorRepository().BuscarRecebedoresPorCampo(context.Background(), "x", "apelido", 10, 0)
+	assert.Error(t, err)
+}
+
+func TestRecebedorRepository_NaoExpoeArmazenados(t *testing.T) {
+	repo := NewRecebedorRepository()
+	recebedor := &domain.Recebedor{Nome: "ana", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}
+	assert.NoError(t, repo.CriarRecebedor(context.Background(), recebedor))
+	recebedor.Nome = "alterado"
+
+	encontrado, _ := repo.BuscarRecebedorPorId(context.Background(), recebedor.Id)
+	encontrado.Nome = "alterado"
+	armazenado, _ := repo.BuscarRecebedorPorId(context.Background(), recebedor.Id)
+	assert.Equal(t, "ana", armazenado.Nome)
+}
@@ -0,0 +1,190 @@
+package metricas
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/brcode"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	namespace = "transfeera"
+
+	timeoutColetaPadrao = 2 * time.Second
+)
+
+var (
+	RequisicoesHttp = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requisicoes_total",
+		Help:      "Quantidade de requisições http por rota e status.",
+	}, []string{"metodo", "rota", "status"})
+
+	DuracaoHttp = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_requisicao_duracao_segundos",
+		Help:      "Latência das requisições http por rota e status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"metodo", "rota", "status"})
+
+	ErrosServico = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "servico_erros_total",
+		Help:      "Quantidade de erros retornados pelos serviços da aplicação por tipo de erro.",
+	}, []string{"erro"})
+
+	ErrosTarefas = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tarefa_erros_total",
+		Help:      "Quantidade de erros das tarefas executadas em segundo plano por tarefa e tipo de erro.",
+	}, []string{"tarefa", "erro"})
+
+	ConsultasCache = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_consultas_total",
+		Help:      "Consultas ao cache de recebedores por operação e resultado (acerto ou falha).",
+	}, []string{"operacao", "resultado"})
+
+	RequisicoesLimitadas = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requisicoes_limitadas_total",
+		Help:      "Requisições recusadas pelo limite de requisições por grupo de rotas.",
+	}, []string{"grupo"})
+)
+
+// nome de cada erro conhecido usado como label, os demais erros são contabilizados como "interno"
+var nomesErros = []struct {
+	err  error
+	nome string
+}{
+	{domain.ErrEmailInvalido, "email_invalido"},
+	{domain.ErrNomeInvalido, "nome_invalido"},
+	{domain.ErrChaveInvalida, "chave_invalida"},
+	{domain.ErrTipoChaveInvalida, "tipo_chave_invalida"},
+	{domain.ErrChaveTipoNaoCorresponde, "chave_tipo_nao_corresponde"},
+	{domain.ErrCpfInvalido, "cpf_invalido"},
+	{domain.ErrCnpjInvalido, "cnpj_invalido"},
+	{domain.ErrRecebedorNaoEncontrado, "recebedor_nao_encontrado"},
+	{domain.ErrRecebedorNaoPermiteEdicao, "recebedor_nao_permite_edicao"},
+	{domain.ErrChavePixJaCadastrada, "chave_pix_ja_cadastrada"},
+	{domain.ErrUrlWebhookInvalida, "url_webhook_invalida"},
+	{domain.ErrEventoInvalido, "evento_invalido"},
+	{domain.ErrWebhookNaoEncontrado, "webhook_nao_encontrado"},
+	{domain.ErrEntregaNaoEncontrada, "entrega_nao_encontrada"},
+	{domain.ErrRecebedorBloqueado, "recebedor_bloqueado"},
+	{domain.ErrOcorrenciaNaoEncontrada, "ocorrencia_nao_encontrada"},
+	{domain.ErrOcorrenciaJaRevisada, "ocorrencia_ja_revisada"},
+	{domain.ErrDecisaoInvalida, "decisao_invalida"},
+	{domain.ErrStatusOcorrenciaInvalido, "status_ocorrencia_invalido"},
+	{domain.ErrTokenVerificacaoInvalido, "token_verificacao_invalido"},
+	{domain.ErrTokenVerificacaoExpirado, "token_verificacao_expirado"},
+	{domain.ErrEmailJaVerificado, "email_ja_verificado"},
+	{brcode.ErrValorInvalido, "brcode_valor_invalido"},
+	{brcode.ErrTxIdInvalido, "brcode_txid_invalido"},
+	{brcode.ErrCampoMuitoLongo, "brcode_campo_muito_longo"},
+	{brcode.ErrBrCodeInvalido, "brcode_invalido"},
+	{brcode.ErrCrcInvalido, "brcode_crc_invalido"},
+	{brcode.ErrChaveAusente, "brcode_chave_ausente"},
+	{context.DeadlineExceeded, "tempo_esgotado"},
+}
+
+// retorna o label do erro, a busca não usa o erro como chave de map pois
+// alguns erros (ex: validator.ValidationErrors) não são comparáveis
+func NomeErro(err error) string {
+	for _, e := range nomesErros {
+		if errors.Is(err, e.err) {
+			return e.nome
+		}
+	}
+	var naoDeletados domain.ErrRecebedoresNaoDeletados
+	if errors.As(err, &naoDeletados) {
+		return "recebedores_nao_deletados"
+	}
+	return "interno"
+}
+
+// contabiliza o erro retornado por um serviço
+func RegistrarErro(err error) {
+	ErrosServico.WithLabelValues(NomeErro(err)).Inc()
+}
+
+// contabiliza o erro de uma tarefa em segundo plano, como o relay do outbox e o envio de webhooks
+func RegistrarErroTarefa(tarefa string, err error) {
+	ErrosTarefas.WithLabelValues(tarefa, NomeErro(err)).Inc()
+}
+
+// handler que expõe as métricas no formato do Prometheus
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ContadorRecebedores retorna a quantidade de recebedores agrupada por status e tipo de chave
+type ContadorRecebedores interface {
+	ContarRecebedoresAgrupados(ctx context.Context) ([]domain.ContagemRecebedores, error)
+}
+
+// registra as métricas do pool de conexões do banco de dados (postgres ou sqlite) e as métricas
+// de negócio dos recebedores
+func RegistrarDatabase(db *sql.DB, nome string, contador ContadorRecebedores) error {
+	if err := prometheus.Register(collectors.NewDBStatsCollector(db, nome)); err != nil {
+		return err
+	}
+	return RegistrarRecebedores(contador)
+}
+
+// registra as métricas de negócio dos recebedores, para os repositórios sem banco de dados
+func RegistrarRecebedores(contador ContadorRecebedores) error {
+	return prometheus.Register(NewColetorRecebedores(contador))
+}
+
+// ColetorRecebedores consulta a quantidade de recebedores a cada coleta do Prometheus
+type ColetorRecebedores struct {
+	contador  ContadorRecebedores
+	timeout   time.Duration
+	porStatus *prometheus.Desc
+	porTipo   *prometheus.Desc
+}
+
+func NewColetorRecebedores(contador ContadorRecebedores) *ColetorRecebedores {
+	return &ColetorRecebedores{
+		contador:  contador,
+		timeout:   timeoutColetaPadrao,
+		porStatus: prometheus.NewDesc(namespace+"_recebedores_por_status", "Quantidade de recebedores por status.", []string{"status"}, nil),
+		porTipo:   prometheus.NewDesc(namespace+"_recebedores_por_tipo_chave", "Quantidade de recebedores por tipo de chave pix.", []string{"tipo_chave"}, nil),
+	}
+}
+
+func (c *ColetorRecebedores) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.porStatus
+	ch <- c.porTipo
+}
+
+func (c *ColetorRecebedores) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	contagens, err := c.contador.ContarRecebedoresAgrupados(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.porStatus, err)
+		return
+	}
+	porStatus := map[string]int{}
+	porTipo := map[string]int{}
+	for _, contagem := range contagens {
+		porStatus[contagem.Status] += contagem.Total
+		porTipo[contagem.TipoChavePix] += contagem.Total
+	}
+	for status, total := range porStatus {
+		ch <- prometheus.MustNewConstMetric(c.porStatus, prometheus.GaugeValue, float64(total), status)
+	}
+	for tipo, total := range porTipo {
+		ch <- prometheus.MustNewConstMetric(c.porTipo, prometheus.GaugeValue, float64(total), tipo)
+	}
+}
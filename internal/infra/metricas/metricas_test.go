@@ -0,0 +1,71 @@
+package metricas
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type contadorFake struct {
+	contagens []domain.ContagemRecebedores
+	err       error
+}
+
+func (c contadorFake) ContarRecebedoresAgrupados(ctx context.Context) ([]domain.ContagemRecebedores, error) {
+	return c.contagens, c.err
+}
+
+// erro não comparável, como validator.ValidationErrors
+type errosLista []string
+
+func (e errosLista) Error() string { return strings.Join(e, ", ") }
+
+func TestNomeErro(t *testing.T) {
+	assert.Equal(t, "recebedor_nao_encontrado", NomeErro(domain.ErrRecebedorNaoEncontrado))
+	assert.Equal(t, "recebedores_nao_deletados", NomeErro(domain.ErrRecebedoresNaoDeletados{IdsSemSucesso: []uint{1}}))
+	assert.Equal(t, "tempo_esgotado", NomeErro(context.DeadlineExceeded))
+	assert.Equal(t, "interno", NomeErro(errors.New("conexão recusada")))
+	assert.Equal(t, "interno", NomeErro(errosLista{"nome", "email"}))
+}
+
+func TestRegistrarErro(t *testing.T) {
+	antes := testutil.ToFloat64(ErrosServico.WithLabelValues("cpf_invalido"))
+	RegistrarErro(domain.ErrCpfInvalido)
+	assert.Equal(t, antes+1, testutil.ToFloat64(ErrosServico.WithLabelValues("cpf_invalido")))
+}
+
+func TestRegistrarErroTarefa(t *testing.T) {
+	antes := testutil.ToFloat64(ErrosTarefas.WithLabelValues("outbox", "interno"))
+	RegistrarErroTarefa("outbox", errors.New("conexão recusada"))
+	assert.Equal(t, antes+1, testutil.ToFloat64(ErrosTarefas.WithLabelValues("outbox", "interno")))
+}
+
+func TestColetorRecebedores(t *testing.T) {
+	coletor := NewColetorRecebedores(contadorFake{contagens: []domain.ContagemRecebedores{
+		{Status: domain.StatusValidado, TipoChavePix: "CPF", Total: 3},
+		{Status: domain.StatusRascunho, TipoChavePix: "CPF", Total: 2},
+		{Status: domain.StatusRascunho, TipoChavePix: "EMAIL", Total: 1},
+	}})
+	esperado := `
+# HELP transfeera_recebedores_por_status Quantidade de recebedores por status.
+# TYPE transfeera_recebedores_por_status gauge
+transfeera_recebedores_por_status{status="Rascunho"} 3
+transfeera_recebedores_por_status{status="Validado"} 3
+# HELP transfeera_recebedores_por_tipo_chave Quantidade de recebedores por tipo de chave pix.
+# TYPE transfeera_recebedores_por_tipo_chave gauge
+transfeera_recebedores_por_tipo_chave{tipo_chave="CPF"} 5
+transfeera_recebedores_por_tipo_chave{tipo_chave="EMAIL"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(coletor, strings.NewReader(esperado)))
+}
+
+func TestColetorRecebedores_ErroConsulta(t *testing.T) {
+	coletor := NewColetorRecebedores(contadorFake{err: errors.New("banco indisponível")})
+	_, err := testutil.CollectAndLint(coletor)
+	assert.Error(t, err)
+}
@@ -0,0 +1,13 @@
+-- as restrições equivalem ao enum do tipo de chave e ao índice único da chave pix do postgres
+CREATE TABLE recebedores (
+	recebedor_id INTEGER PRIMARY KEY AUTOINCREMENT,
+	cpf_cnpj VARCHAR(20) NOT NULL,
+	nome VARCHAR(100) NOT NULL,
+	tipo_chave_pix TEXT NOT NULL CHECK (tipo_chave_pix IN ('CPF', 'CNPJ', 'EMAIL', 'TELEFONE', 'CHAVE_ALEATORIA')),
+	chave_pix VARCHAR(140) NOT NULL UNIQUE,
+	status_recebedor VARCHAR(15) DEFAULT 'Rascunho',
+	email VARCHAR(250) DEFAULT NULL
+);
+
+CREATE INDEX recebedores_nome_idx ON recebedores (nome);
+CREATE INDEX recebedores_status_idx ON recebedores (status_recebedor);
@@ -0,0 +1,15 @@
+-- as chaves dos recebedores deletados são mantidas para a detecção de recebedores suspeitos
+CREATE TABLE chaves_deletadas (
+	chave_pix VARCHAR(140) NOT NULL,
+	recebedor_id INTEGER NOT NULL,
+	cpf_cnpj VARCHAR(20) NOT NULL,
+	nome VARCHAR(100) NOT NULL,
+	deletado_em TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%SZ', 'now'))
+);
+
+CREATE INDEX chaves_deletadas_chave_idx ON chaves_deletadas (chave_pix);
+
+CREATE TRIGGER recebedores_deletados AFTER DELETE ON recebedores
+BEGIN
+	INSERT INTO chaves_deletadas (chave_pix, recebedor_id, cpf_cnpj, nome) VALUES (OLD.chave_pix, OLD.recebedor_id, OLD.cpf_cnpj, OLD.nome);
+END;
@@ -0,0 +1,18 @@
+-- ocorrências da triagem de listas restritivas, mantidas mesmo após a deleção do recebedor
+CREATE TABLE ocorrencias_triagem (
+	ocorrencia_id INTEGER PRIMARY KEY AUTOINCREMENT,
+	recebedor_id INTEGER NOT NULL,
+	lista VARCHAR(100) NOT NULL,
+	cpf_cnpj_lista VARCHAR(20) NOT NULL DEFAULT '',
+	nome_lista VARCHAR(250) NOT NULL DEFAULT '',
+	motivo TEXT NOT NULL DEFAULT '',
+	criterio VARCHAR(20) NOT NULL,
+	similaridade REAL NOT NULL,
+	status VARCHAR(15) NOT NULL DEFAULT 'Pendente',
+	observacao TEXT NOT NULL DEFAULT '',
+	criado_em TEXT NOT NULL,
+	revisado_em TEXT
+);
+
+CREATE INDEX ocorrencias_triagem_recebedor_idx ON ocorrencias_triagem (recebedor_id);
+CREATE INDEX ocorrencias_triagem_status_idx ON ocorrencias_triagem (status);
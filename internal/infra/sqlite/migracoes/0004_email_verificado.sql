@@ -0,0 +1,2 @@
+-- data da verificação do email pelo token enviado ao recebedor
+ALTER TABLE recebedores ADD COLUMN email_verificado_em TEXT;
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+)
+
+type ocorrenciaRepository struct {
+	DB *sql.DB
+}
+
+// NewOcorrenciaRepository cria o repositório das ocorrências da triagem sobre um banco aberto por Abrir
+func NewOcorrenciaRepository(db *sql.DB) *ocorrenciaRepository {
+	return &ocorrenciaRepository{DB: db}
+}
+
+const colunasOcorrencia = "ocorrencia_id, recebedor_id, lista, cpf_cnpj_lista, nome_lista, motivo, criterio, similaridade, status, observacao, criado_em, revisado_em"
+
+// as datas são armazenadas como texto no formato RFC 3339
+func formatarData(data *time.Time) interface{} {
+	if data == nil {
+		return nil
+	}
+	return data.UTC().Format(time.RFC3339Nano)
+}
+
+// lê a data opcional armazenada por formatarData
+func lerData(valor sql.NullString) (*time.Time, error) {
+	if !valor.Valid {
+		return nil, nil
+	}
+	data, err := time.Parse(time.RFC3339Nano, valor.String)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func scanOcorrencias(rows *sql.Rows, err error) ([]*domain.OcorrenciaTriagem, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ocorrencias := []*domain.OcorrenciaTriagem{}
+	for rows.Next() {
+		var ocorrencia domain.OcorrenciaTriagem
+		var criadoEm string
+		var revisadoEm sql.NullString
+		if err := rows.Scan(&ocorrencia.Id, &ocorrencia.RecebedorId, &ocorrencia.Lista, &ocorrencia.Entrada.CpfCnpj, &ocorrencia.Entrada.Nome, &ocorrencia.Entrada.Motivo,
+			&ocorrencia.Criterio, &ocorrencia.Similaridade, &ocorrencia.Status, &ocorrencia.Observacao, &criadoEm, &revisadoEm); err != nil {
+			return nil, err
+		}
+		if ocorrencia.CriadoEm, err = time.Parse(time.RFC3339Nano, criadoEm); err != nil {
+			return nil, err
+		}
+		if ocorrencia.RevisadoEm, err = lerData(revisadoEm); err != nil {
+			return nil, err
+		}
+		ocorrencias = append(ocorrencias, &ocorrencia)
+	}
+	return ocorrencias, rows.Err()
+}
+
+func (r *ocorrenciaRepository) CriarOcorrencia(ctx context.Context, ocorrencia *domain.OcorrenciaTriagem) error {
+	if ocorrencia.CriadoEm.IsZero() {
+		ocorrencia.CriadoEm = time.Now().UTC()
+	}
+	query := "INSERT INTO ocorrencias_triagem (recebedor_id, lista, cpf_cnpj_lista, nome_lista, motivo, criterio, similaridade, status, observacao, criado_em) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) RETURNING ocorrencia_id"
+	return r.DB.QueryRowContext(ctx, query, ocorrencia.RecebedorId, ocorrencia.Lista, ocorrencia.Entrada.CpfCnpj, ocorrencia.Entrada.Nome, ocorrencia.Entrada.Motivo,
+		ocorrencia.Criterio, ocorrencia.Similaridade, ocorrencia.Status, ocorrencia.Observacao, formatarData(&ocorrencia.CriadoEm)).Scan(&ocorrencia.Id)
+}
+
+func (r *ocorrenciaRepository) BuscarOcorrenciaPorId(ctx context.Context, id uint) (*domain.OcorrenciaTriagem, error) {
+	ocorrencias, err := scanOcorrencias(r.DB.QueryContext(ctx, "SELECT "+colunasOcorrencia+" FROM ocorrencias_triagem WHERE ocorrencia_id = ?", id))
+	if err != nil || len(ocorrencias) == 0 {
+		return nil, err
+	}
+	return ocorrencias[0], nil
+}
+
+func (r *ocorrenciaRepository) ListarOcorrencias(ctx context.Context, status domain.StatusOcorrencia) ([]*domain.OcorrenciaTriagem, error) {
+	query := "SELECT " + colunasOcorrencia + " FROM ocorrencias_triagem WHERE ? = '' OR status = ? ORDER BY ocorrencia_id"
+	return scanOcorrencias(r.DB.QueryContext(ctx, query, status, status))
+}
+
+func (r *ocorrenciaRepository) BuscarOcorrenciasPorRecebedor(ctx context.Context, recebedorId uint) ([]*domain.OcorrenciaTriagem, error) {
+	query := "SELECT " + colunasOcorrencia + " FROM ocorrencias_triagem WHERE recebedor_id = ? ORDER BY ocorrencia_id"
+	return scanOcorrencias(r.DB.QueryContext(ctx, query, recebedorId))
+}
+
+func (r *ocorrenciaRepository) RevisarOcorrencia(ctx context.Context, ocorrencia *domain.OcorrenciaTriagem) error {
+	query := "UPDATE ocorrencias_triagem SET status = ?, observacao = ?, revisado_em = ? WHERE ocorrencia_id = ?"
+	_, err := r.DB.ExecContext(ctx, query, ocorrencia.Status, ocorrencia.Observacao, formatarData(ocorrencia.RevisadoEm), ocorrencia.Id)
+	return err
+}
@@ -0,0 +1,19 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/contrato"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOcorrenciaRepository_Contrato(t *testing.T) {
+	contrato.TestarOcorrenciaRepository(t, func(t *testing.T) domain.OcorrenciaRepository {
+		db, err := Abrir(context.Background(), ":memory:")
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+		return NewOcorrenciaRepository(db)
+	})
+}
@@ -0,0 +1,247 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+type recebedorRepository struct {
+	DB *sql.DB
+}
+
+// NewRecebedorRepository cria o repositório sobre um banco aberto por Abrir. Os eventos dos
+// recebedores não são registrados, pois não há outbox no SQLite
+func NewRecebedorRepository(db *sql.DB) *recebedorRepository {
+	return &recebedorRepository{DB: db}
+}
+
+const colunasRecebedor = "recebedor_id, cpf_cnpj, nome, tipo_chave_pix, chave_pix, status_recebedor, email, email_verificado_em"
+
+func scanRecebedor(linha interface{ Scan(...interface{}) error }) (*domain.Recebedor, error) {
+	var recebedor domain.Recebedor
+	var verificadoEm sql.NullString
+	if err := linha.Scan(&recebedor.Id, &recebedor.CpfCnpj, &recebedor.Nome, &recebedor.TipoChavePix, &recebedor.ChavePix, &recebedor.Status, &recebedor.Email, &verificadoEm); err != nil {
+		return nil, err
+	}
+	var err error
+	recebedor.EmailVerificadoEm, err = lerData(verificadoEm)
+	return &recebedor, err
+}
+
+// traduz as violações das restrições da tabela para os erros do domínio
+func traduzirErro(err error) error {
+	var erroSqlite *sqlite.Error
+	if !errors.As(err, &erroSqlite) {
+		return err
+	}
+	switch erroSqlite.Code() {
+	case sqlite3.SQLITE_CONSTRAINT_UNIQUE:
+		return domain.ErrChavePixJaCadastrada
+	case sqlite3.SQLITE_CONSTRAINT_CHECK:
+		return domain.ErrTipoChaveInvalida
+	}
+	return err
+}
+
+func scanRecebedores(rows *sql.Rows, err error) ([]*domain.Recebedor, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	recebedores := []*domain.Recebedor{}
+	for rows.Next() {
+		recebedor, err := scanRecebedor(rows)
+		if err != nil {
+			return nil, err
+		}
+		recebedores = append(recebedores, recebedor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return recebedores, nil
+}
+
+// placeholders e argumentos do IN, equivalente ao ANY($1) do postgres
+func listaChaves(chaves []string) (string, []interface{}) {
+	args := make([]interface{}, len(chaves))
+	for i, chave := range chaves {
+		args[i] = chave
+	}
+	return strings.TrimSuffix(strings.Repeat("?,", len(chaves)), ","), args
+}
+
+func (r *recebedorRepository) CriarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	query := "INSERT INTO recebedores (cpf_cnpj, nome, tipo_chave_pix, chave_pix, status_recebedor, email) VALUES (?, ?, ?, ?, ?, ?) RETURNING recebedor_id"
+	err := r.DB.QueryRowContext(ctx, query, recebedor.CpfCnpj, recebedor.Nome, recebedor.TipoChavePix, recebedor.ChavePix, recebedor.Status, recebedor.Email).Scan(&recebedor.Id)
+	return traduzirErro(err)
+}
+
+func (r *recebedorRepository) BuscarRecebedorPorId(ctx context.Context, id uint) (*domain.Recebedor, error) {
+	query := "SELECT " + colunasRecebedor + " FROM recebedores WHERE recebedor_id = ?"
+	recebedor, err := scanRecebedor(r.DB.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return recebedor, nil
+}
+
+func (r *recebedorRepository) BuscarChave(ctx context.Context, chave string) (string, error) {
+	var result string
+	err := r.DB.QueryRowContext(ctx, "SELECT chave_pix FROM recebedores WHERE chave_pix = ?", chave).Scan(&result)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+	return result, nil
+}
+
+func (r *recebedorRepository) BuscarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string, limite, offset int) ([]*domain.Recebedor, error) {
+	query := fmt.Sprintf("SELECT "+colunasRecebedor+" FROM recebedores WHERE %s = ? ORDER BY recebedor_id LIMIT ? OFFSET ?", nomeCampo)
+	return scanRecebedores(r.DB.QueryContext(ctx, query, valor, limite, offset))
+}
+
+func (r *recebedorRepository) ContarRecebedoresPorCampo(ctx context.Context, valor, nomeCampo string) (int, error) {
+	query := fmt.Sprintf("SELECT COUNT(recebedor_id) FROM recebedores WHERE %s = ?", nomeCampo)
+	var total int
+	err := r.DB.QueryRowContext(ctx, query, valor).Scan(&total)
+	return total, err
+}
+
+func (r *recebedorRepository) BuscarRecebedoresPorChaves(ctx context.Context, chaves []string, limite, offset int) ([]*domain.Recebedor, error) {
+	placeholders, args := listaChaves(chaves)
+	query := "SELECT " + colunasRecebedor + " FROM recebedores WHERE chave_pix IN (" + placeholders + ") ORDER BY recebedor_id LIMIT ? OFFSET ?"
+	return scanRecebedores(r.DB.QueryContext(ctx, query, append(args, limite, offset)...))
+}
+
+func (r *recebedorRepository) ContarRecebedoresPorChaves(ctx context.Context, chaves []string) (int, error) {
+	placeholders, args := listaChaves(chaves)
+	var total int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(recebedor_id) FROM recebedores WHERE chave_pix IN ("+placeholders+")", args...).Scan(&total)
+	return total, err
+}
+
+func (r *recebedorRepository) ContarRecebedoresAgrupados(ctx context.Context) ([]domain.ContagemRecebedores, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT status_recebedor, tipo_chave_pix, COUNT(recebedor_id) FROM recebedores GROUP BY status_recebedor, tipo_chave_pix")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	contagens := []domain.ContagemRecebedores{}
+	for rows.Next() {
+		var contagem domain.ContagemRecebedores
+		if err := rows.Scan(&contagem.Status, &contagem.TipoChavePix, &contagem.Total); err != nil {
+			return nil, err
+		}
+		contagens = append(contagens, contagem)
+	}
+	return contagens, rows.Err()
+}
+
+func (r *recebedorRepository) EditarRecebedor(ctx context.Context, recebedor *domain.Recebedor) error {
+	colunas := []string{}
+	values := []interface{}{}
+	for _, campo := range []struct {
+		coluna string
+		valor  string
+	}{
+		{"cpf_cnpj", recebedor.CpfCnpj},
+		{"nome", recebedor.Nome},
+		{"tipo_chave_pix", string(recebedor.TipoChavePix)},
+		{"chave_pix", recebedor.ChavePix},
+		{"email", recebedor.Email},
+	} {
+		if campo.valor != "" {
+			colunas = append(colunas, campo.coluna+" = ?")
+			values = append(values, campo.valor)
+		}
+	}
+	if len(colunas) == 0 {
+		return nil
+	}
+	if recebedor.Email != "" {
+		colunas = append([]string{resetarVerificacao}, colunas...)
+		values = append([]interface{}{recebedor.Email}, values...)
+	}
+	query := "UPDATE recebedores SET " + strings.Join(colunas, ", ") + " WHERE recebedor_id = ?"
+	_, err := r.DB.ExecContext(ctx, query, append(values, recebedor.Id)...)
+	return traduzirErro(err)
+}
+
+// a verificação é removida se o email for alterado, as expressões do SET usam os valores anteriores à alteração
+const resetarVerificacao = "email_verificado_em = CASE WHEN email = ? THEN email_verificado_em END"
+
+func (r *recebedorRepository) EditarEmailRecebedor(ctx context.Context, id uint, email string) error {
+	_, err := r.DB.ExecContext(ctx, "UPDATE recebedores SET "+resetarVerificacao+", email = ? WHERE recebedor_id = ?", email, email, id)
+	return err
+}
+
+func (r *recebedorRepository) VerificarEmailRecebedor(ctx context.Context, id uint, email string, verificadoEm time.Time) (bool, error) {
+	query := "UPDATE recebedores SET email_verificado_em = ? WHERE recebedor_id = ? AND email = ? AND email_verificado_em IS NULL"
+	resultado, err := r.DB.ExecContext(ctx, query, formatarData(&verificadoEm), id, email)
+	if err != nil {
+		return false, err
+	}
+	alterados, err := resultado.RowsAffected()
+	return alterados > 0, err
+}
+
+func (r *recebedorRepository) EditarStatusRecebedor(ctx context.Context, id uint, status string) error {
+	_, err := r.DB.ExecContext(ctx, "UPDATE recebedores SET status_recebedor = ? WHERE recebedor_id = ?", status, id)
+	return err
+}
+
+func (r *recebedorRepository) DeletarRecebedor(ctx context.Context, id uint) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM recebedores WHERE recebedor_id = ?", id)
+	return err
+}
+
+// Deprecated: não utilizar
+func (r *recebedorRepository) DeletarRecebedores(ctx context.Context, ids []uint) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM recebedores WHERE recebedor_id = ?", id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// chaves registradas pelo gatilho de deleção da tabela recebedores
+func (r *recebedorRepository) BuscarChavesDeletadas(ctx context.Context) ([]domain.ChaveDeletada, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT chave_pix, recebedor_id, cpf_cnpj, nome, deletado_em FROM chaves_deletadas ORDER BY deletado_em")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	deletadas := []domain.ChaveDeletada{}
+	for rows.Next() {
+		var deletada domain.ChaveDeletada
+		var deletadoEm string
+		if err := rows.Scan(&deletada.ChavePix, &deletada.RecebedorId, &deletada.CpfCnpj, &deletada.Nome, &deletadoEm); err != nil {
+			return nil, err
+		}
+		if deletada.DeletadoEm, err = time.Parse(time.RFC3339, deletadoEm); err != nil {
+			return nil, err
+		}
+		deletadas = append(deletadas, deletada)
+	}
+	return deletadas, rows.Err()
+}
@@ -0,0 +1,55 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/contrato"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func novoRepositorio(t *testing.T) *recebedorRepository {
+	db, err := Abrir(context.Background(), ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return NewRecebedorRepository(db)
+}
+
+func TestRecebedorRepository_Contrato(t *testing.T) {
+	contrato.TestarRecebedorRepository(t, func(t *testing.T) domain.RecebedorRepository {
+		return novoRepositorio(t)
+	})
+}
+
+func TestRecebedorRepository_ChaveDuplicada(t *testing.T) {
+	repo := novoRepositorio(t)
+	ctx := context.Background()
+	recebedor := &domain.Recebedor{CpfCnpj: "38836148077", Nome: "ana", TipoChavePix: domain.Cpf, ChavePix: "38836148077", Status: domain.StatusRascunho}
+	require.NoError(t, repo.CriarRecebedor(ctx, recebedor))
+	outro := &domain.Recebedor{CpfCnpj: "99440547049", Nome: "pedro", TipoChavePix: domain.Cpf, ChavePix: "99440547049", Status: domain.StatusRascunho}
+	require.NoError(t, repo.CriarRecebedor(ctx, outro))
+
+	duplicado := *recebedor
+	assert.ErrorIs(t, repo.CriarRecebedor(ctx, &duplicado), domain.ErrChavePixJaCadastrada)
+	assert.ErrorIs(t, repo.EditarRecebedor(ctx, &domain.Recebedor{Id: outro.Id, ChavePix: recebedor.ChavePix}), domain.ErrChavePixJaCadastrada)
+}
+
+func TestAbrir_MigracoesAplicadasUmaVez(t *testing.T) {
+	arquivo := filepath.Join(t.TempDir(), "recebedores.db")
+	db, err := Abrir(context.Background(), arquivo)
+	require.NoError(t, err)
+	repo := NewRecebedorRepository(db)
+	require.NoError(t, repo.CriarRecebedor(context.Background(), &domain.Recebedor{Nome: "ana", TipoChavePix: domain.Email, ChavePix: "ana@transfeera.com"}))
+	require.NoError(t, db.Close())
+
+	//reabrir o arquivo não reaplica as migrações e mantém os recebedores
+	db, err = Abrir(context.Background(), arquivo)
+	require.NoError(t, err)
+	defer db.Close()
+	total, err := NewRecebedorRepository(db).ContarRecebedoresPorCampo(context.Background(), "ana", "nome")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+}
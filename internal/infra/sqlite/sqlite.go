@@ -0,0 +1,87 @@
+// Package sqlite implementa o repositório de recebedores em um arquivo SQLite,
+// para instalações em uma única máquina sem o Postgres
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+//go:embed migracoes/*.sql
+var arquivosMigracoes embed.FS
+
+// Abrir abre o arquivo do banco de dados, criando-o se não existir, e aplica as migrações pendentes.
+// O caminho ":memory:" cria um banco em memória
+func Abrir(ctx context.Context, caminho string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", "file:"+caminho+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)")
+	if err != nil {
+		return nil, err
+	}
+	//o sqlite permite apenas uma escrita por vez, e cada conexão com ":memory:" é um banco diferente
+	db.SetMaxOpenConns(1)
+	if err := migrar(ctx, db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// aplica em ordem as migrações ainda não registradas na tabela schema_migrations,
+// cada uma em sua transação
+func migrar(ctx context.Context, db *sql.DB) error {
+	query := "CREATE TABLE IF NOT EXISTS schema_migrations (versao INTEGER PRIMARY KEY, nome TEXT NOT NULL, aplicada_em TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP)"
+	if _, err := db.ExecContext(ctx, query); err != nil {
+		return err
+	}
+	//os arquivos são retornados em ordem lexicográfica, que é a ordem das versões
+	caminhos, err := fs.Glob(arquivosMigracoes, "migracoes/*.sql")
+	if err != nil {
+		return err
+	}
+	for _, caminho := range caminhos {
+		nome := strings.TrimSuffix(path.Base(caminho), ".sql")
+		versao, err := strconv.Atoi(strings.SplitN(nome, "_", 2)[0])
+		if err != nil {
+			return fmt.Errorf("nome de migração inválido: %s", caminho)
+		}
+		var aplicada bool
+		if err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE versao = ?)", versao).Scan(&aplicada); err != nil {
+			return err
+		}
+		if aplicada {
+			continue
+		}
+		script, err := fs.ReadFile(arquivosMigracoes, caminho)
+		if err != nil {
+			return err
+		}
+		if err := aplicar(ctx, db, versao, nome, string(script)); err != nil {
+			return fmt.Errorf("aplicando a migração %s: %w", nome, err)
+		}
+	}
+	return nil
+}
+
+func aplicar(ctx context.Context, db *sql.DB, versao int, nome, script string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (versao, nome) VALUES (?, ?)", versao, nome); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderAssinatura = "X-Webhook-Assinatura"
+	HeaderTimestamp  = "X-Webhook-Timestamp"
+	HeaderEvento     = "X-Webhook-Evento"
+	HeaderEntrega    = "X-Webhook-Entrega"
+
+	intervaloPadrao     = 5 * time.Second
+	timeoutPadrao       = 10 * time.Second
+	backoffPadrao       = 30 * time.Second
+	maxTentativasPadrao = 8
+	lotePadrao          = 50
+
+	tarefaWebhooks = "webhooks" //label das métricas de erros do despachante
+)
+
+// Despachante envia as entregas pendentes de webhooks. Cada envio é assinado com HMAC-SHA256
+// e, em caso de falha, é reagendado com backoff exponencial até esgotar as tentativas, quando
+// a entrega passa para o status Falha (dead-letter) e só é reenviada manualmente
+type Despachante struct {
+	repo          domain.WebhookRepository
+	client        *http.Client
+	logger        *zap.Logger
+	intervalo     time.Duration
+	backoff       time.Duration
+	maxTentativas int
+	lote          int
+}
+
+func NewDespachante(repo domain.WebhookRepository, logger *zap.Logger) *Despachante {
+	return &Despachante{
+		repo:          repo,
+		client:        &http.Client{Timeout: timeoutPadrao},
+		logger:        logger,
+		intervalo:     intervaloPadrao,
+		backoff:       backoffPadrao,
+		maxTentativas: maxTentativasPadrao,
+		lote:          lotePadrao,
+	}
+}
+
+// processa as entregas pendentes periodicamente até o contexto ser cancelado
+func (d *Despachante) Executar(ctx context.Context) {
+	ticker := time.NewTicker(d.intervalo)
+	defer ticker.Stop()
+	for {
+		d.processar(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// envia um lote de entregas pendentes
+func (d *Despachante) processar(ctx context.Context) {
+	//a reserva deve cobrir o tempo de envio de todo o lote
+	entregas, err := d.repo.ReservarEntregasPendentes(ctx, d.lote, d.client.Timeout*time.Duration(d.lote))
+	if err != nil {
+		d.logger.Error("reservando entregas de webhook", zap.Error(err))
+		metricas.RegistrarErroTarefa(tarefaWebhooks, err)
+		return
+	}
+	for _, entrega := range entregas {
+		if ctx.Err() != nil {
+			return
+		}
+		d.entregar(ctx, entrega)
+	}
+}
+
+// envia uma entrega e atualiza o seu status de acordo com o resultado
+func (d *Despachante) entregar(ctx context.Context, entrega *domain.EntregaWebhook) {
+	webhook, err := d.repo.BuscarWebhookPorId(ctx, entrega.WebhookId)
+	if err != nil {
+		d.logger.Error("consultando webhook", zap.Error(err), zap.Uint("webhook_id", entrega.WebhookId))
+		metricas.RegistrarErroTarefa(tarefaWebhooks, err)
+		return
+	}
+	if webhook == nil {
+		return
+	}
+	entrega.Tentativas++
+	if err := d.enviar(ctx, webhook, entrega); err != nil {
+		entrega.UltimoErro = err.Error()
+		if entrega.Tentativas >= d.maxTentativas {
+			entrega.Status = domain.EntregaFalha
+			d.logger.Warn("entrega de webhook esgotou as tentativas", zap.Uint("entrega_id", entrega.Id), zap.Error(err))
+		} else {
+			//backoff exponencial: backoff, 2*backoff, 4*backoff...
+			entrega.ProximaTentativa = time.Now().UTC().Add(d.backoff << (entrega.Tentativas - 1))
+		}
+	} else {
+		entrega.Status = domain.EntregaEntregue
+		entrega.UltimoErro = ""
+	}
+	if err := d.repo.AtualizarEntrega(ctx, entrega); err != nil {
+		d.logger.Error("atualizando entrega de webhook", zap.Error(err), zap.Uint("entrega_id", entrega.Id))
+		metricas.RegistrarErroTarefa(tarefaWebhooks, err)
+	}
+}
+
+// realiza o POST do evento para a url do webhook, qualquer status diferente de 2xx é considerado falha
+func (d *Despachante) enviar(ctx context.Context, webhook *domain.Webhook, entrega *domain.EntregaWebhook) error {
+	corpo, err := json.Marshal(entrega.Evento)
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(corpo))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvento, string(entrega.Evento.Tipo))
+	req.Header.Set(HeaderEntrega, strconv.FormatUint(uint64(entrega.Id), 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderAssinatura, "sha256="+Assinar(webhook.Segredo, timestamp, corpo))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook respondeu com status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Assinar retorna a assinatura HMAC-SHA256 em hexadecimal de timestamp + "." + corpo,
+// o destinatário deve recalcular a assinatura com o segredo do webhook para validar o envio
+func Assinar(segredo, timestamp string, corpo []byte) string {
+	mac := hmac.New(sha256.New, []byte(segredo))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(corpo)
+	return hex.EncodeToString(mac.Sum(nil))
+}
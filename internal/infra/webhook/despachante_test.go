@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/domain"
+	"github.com/flaviorodolfo/transfeera-challenge/internal/infra/metricas"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// repositório em memória com um webhook e as entregas informadas
+type repositorioFake struct {
+	domain.WebhookRepository
+	webhook     *domain.Webhook
+	entregas    []*domain.EntregaWebhook
+	atualizadas []domain.EntregaWebhook
+	erro        error
+}
+
+func (r *repositorioFake) BuscarWebhookPorId(ctx context.Context, id uint) (*domain.Webhook, error) {
+	return r.webhook, nil
+}
+func (r *repositorioFake) ReservarEntregasPendentes(ctx context.Context, limite int, reserva time.Duration) ([]*domain.EntregaWebhook, error) {
+	entregas := r.entregas
+	r.entregas = nil
+	return entregas, r.erro
+}
+func (r *repositorioFake) AtualizarEntrega(ctx context.Context, entrega *domain.EntregaWebhook) error {
+	r.atualizadas = append(r.atualizadas, *entrega)
+	return nil
+}
+
+func novoDespachante(repo domain.WebhookRepository) *Despachante {
+	d := NewDespachante(repo, zap.NewNop())
+	d.maxTentativas = 3
+	return d
+}
+
+func TestDespachante_EntregaAssinada(t *testing.T) {
+	var assinatura, timestamp, evento string
+	var corpo []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assinatura = r.Header.Get(HeaderAssinatura)
+		timestamp = r.Header.Get(HeaderTimestamp)
+		evento = r.Header.Get(HeaderEvento)
+		corpo, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := &repositorioFake{
+		webhook: &domain.Webhook{Id: 1, Url: server.URL, Segredo: "segredo"},
+		entregas: []*domain.EntregaWebhook{{
+			Id:        1,
+			WebhookId: 1,
+			Evento:    domain.Evento{Id: "1", Tipo: domain.EventoRecebedorCriado, Recebedor: &domain.Recebedor{Id: 1}},
+			Status:    domain.EntregaPendente,
+		}},
+	}
+	novoDespachante(repo).processar(context.Background())
+
+	assert.Equal(t, "sha256="+Assinar("segredo", timestamp, corpo), assinatura)
+	assert.Equal(t, string(domain.EventoRecebedorCriado), evento)
+	assert.Len(t, repo.atualizadas, 1)
+	assert.Equal(t, domain.EntregaEntregue, repo.atualizadas[0].Status)
+	assert.Equal(t, 1, repo.atualizadas[0].Tentativas)
+}
+
+func TestDespachante_FalhaComBackoff(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	repo := &repositorioFake{
+		webhook: &domain.Webhook{Id: 1, Url: server.URL, Segredo: "segredo"},
+		entregas: []*domain.EntregaWebhook{{
+			Id:         1,
+			WebhookId:  1,
+			Status:     domain.EntregaPendente,
+			Tentativas: 1,
+		}},
+	}
+	antes := time.Now().UTC()
+	novoDespachante(repo).processar(context.Background())
+
+	entrega := repo.atualizadas[0]
+	assert.Equal(t, domain.EntregaPendente, entrega.Status)
+	assert.Equal(t, 2, entrega.Tentativas)
+	assert.Contains(t, entrega.UltimoErro, "500")
+	//segunda tentativa: backoff * 2
+	assert.WithinDuration(t, antes.Add(2*backoffPadrao), entrega.ProximaTentativa, time.Second)
+}
+
+func TestDespachante_EsgotaTentativas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	repo := &repositorioFake{
+		webhook: &domain.Webhook{Id: 1, Url: server.URL, Segredo: "segredo"},
+		entregas: []*domain.EntregaWebhook{{
+			Id:         1,
+			WebhookId:  1,
+			Status:     domain.EntregaPendente,
+			Tentativas: 2,
+		}},
+	}
+	novoDespachante(repo).processar(context.Background())
+
+	assert.Equal(t, domain.EntregaFalha, repo.atualizadas[0].Status)
+	assert.Equal(t, 3, repo.atualizadas[0].Tentativas)
+}
+
+func TestDespachante_ErroContabilizado(t *testing.T) {
+	antes := testutil.ToFloat64(metricas.ErrosTarefas.WithLabelValues(tarefaWebhooks, "interno"))
+	novoDespachante(&repositorioFake{erro: errors.New("conexão recusada")}).processar(context.Background())
+	assert.Equal(t, antes+1, testutil.ToFloat64(metricas.ErrosTarefas.WithLabelValues(tarefaWebhooks, "interno")))
+}
+
+func TestAssinar(t *testing.T) {
+	//hmac-sha256 de "1700000000.{}" com o segredo "segredo"
+	assinatura := Assinar("segredo", "1700000000", []byte("{}"))
+	assert.Len(t, assinatura, 64)
+	assert.Equal(t, assinatura, Assinar("segredo", "1700000000", []byte("{}")))
+	assert.NotEqual(t, assinatura, Assinar("outro", "1700000000", []byte("{}")))
+}
@@ -0,0 +1,122 @@
+package mascara
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// campos de log cujo valor é sempre um dado pessoal
+var camposSensiveis = map[string]bool{
+	"cpf":       true,
+	"cnpj":      true,
+	"cpf_cnpj":  true,
+	"email":     true,
+	"chave":     true,
+	"chave_pix": true,
+}
+
+// campos de correlação que não passam pela busca de dados pessoais
+var camposCorrelacao = map[string]bool{
+	"trace_id":   true,
+	"span_id":    true,
+	"request_id": true,
+}
+
+// o CNPJ alfanumérico aceita letras maiúsculas nas doze primeiras posições, os dígitos verificadores são numéricos
+var (
+	regexEmail    = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	regexCnpj     = regexp.MustCompile(`\b[0-9A-Z]{2}\.?[0-9A-Z]{3}\.?[0-9A-Z]{3}/?[0-9A-Z]{4}-?\d{2}\b`)
+	regexCpf      = regexp.MustCompile(`\b\d{3}\.?\d{3}\.?\d{3}-?\d{2}\b`)
+	regexTelefone = regexp.MustCompile(`\+55\d{10,11}\b`)
+)
+
+// Valor oculta as letras e os dígitos do valor, mantendo os separadores e os dois últimos caracteres
+func Valor(valor string) string {
+	runas := []rune(valor)
+	for i := 0; i < len(runas)-2; i++ {
+		if unicode.IsLetter(runas[i]) || unicode.IsDigit(runas[i]) {
+			runas[i] = '*'
+		}
+	}
+	return string(runas)
+}
+
+// Email oculta o usuário do email, mantendo a primeira letra e o domínio
+func Email(email string) string {
+	arroba := strings.LastIndex(email, "@")
+	if arroba < 1 {
+		return Valor(email)
+	}
+	return email[:1] + strings.Repeat("*", arroba-1) + email[arroba:]
+}
+
+// Texto oculta os emails, CPFs, CNPJs e telefones encontrados no texto
+func Texto(texto string) string {
+	texto = regexEmail.ReplaceAllStringFunc(texto, Email)
+	texto = regexCnpj.ReplaceAllStringFunc(texto, Valor)
+	texto = regexCpf.ReplaceAllStringFunc(texto, Valor)
+	return regexTelefone.ReplaceAllStringFunc(texto, Valor)
+}
+
+func campo(chave, valor string) string {
+	if camposSensiveis[strings.ToLower(chave)] {
+		if strings.Contains(valor, "@") {
+			return Email(valor)
+		}
+		return Valor(valor)
+	}
+	if camposCorrelacao[chave] {
+		return valor
+	}
+	return Texto(valor)
+}
+
+func mascararCampos(campos []zapcore.Field) []zapcore.Field {
+	mascarados := make([]zapcore.Field, len(campos))
+	for i, c := range campos {
+		switch {
+		case c.Type == zapcore.StringType:
+			c.String = campo(c.Key, c.String)
+		case c.Type == zapcore.ErrorType:
+			//o erro é registrado como texto para que a mensagem também seja mascarada
+			if err, ok := c.Interface.(error); ok && err != nil {
+				c = zapcore.Field{Key: c.Key, Type: zapcore.StringType, String: Texto(err.Error())}
+			}
+		case camposSensiveis[strings.ToLower(c.Key)] && c.Interface != nil:
+			c = zapcore.Field{Key: c.Key, Type: zapcore.StringType, String: campo(c.Key, fmt.Sprint(c.Interface))}
+		}
+		mascarados[i] = c
+	}
+	return mascarados
+}
+
+type core struct {
+	zapcore.Core
+}
+
+// NewCore envolve o core do zap, mascarando os dados pessoais da mensagem e de todos os campos
+// do log: os campos sensíveis (cpf_cnpj, email, chave...) são sempre ocultados e nos demais
+// são ocultados os emails, CPFs, CNPJs e telefones encontrados
+func NewCore(c zapcore.Core) zapcore.Core {
+	return core{Core: c}
+}
+
+func (c core) With(campos []zapcore.Field) zapcore.Core {
+	return core{Core: c.Core.With(mascararCampos(campos))}
+}
+
+func (c core) Check(entrada zapcore.Entry, verificada *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entrada.Level) {
+		return verificada.AddCore(entrada, c)
+	}
+	return verificada
+}
+
+func (c core) Write(entrada zapcore.Entry, campos []zapcore.Field) error {
+	entrada.Message = Texto(entrada.Message)
+	return c.Core.Write(entrada, mascararCampos(campos))
+}
@@ -0,0 +1,55 @@
+package mascara
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestValor(t *testing.T) {
+	assert.Equal(t, "***.***.***-09", Valor("123.456.789-09"))
+	assert.Equal(t, "**.***.***/****-95", Valor("11.222.333/0001-95"))
+	assert.Equal(t, "+***********99", Valor("+5511987654399"))
+	assert.Equal(t, "ab", Valor("ab"))
+}
+
+func TestEmail(t *testing.T) {
+	assert.Equal(t, "f*****@transfeera.com", Email("fulano@transfeera.com"))
+	assert.Equal(t, "********.*om", Email("invalido.com"))
+}
+
+func TestTexto(t *testing.T) {
+	assert.Equal(t, "recebedor *********09 com email f*****@transfeera.com",
+		Texto("recebedor 12345678909 com email fulano@transfeera.com"))
+	assert.Equal(t, "telefone +***********99", Texto("telefone +5511987654399"))
+	assert.Equal(t, "recebedor 42 não existe", Texto("recebedor 42 não existe"))
+	assert.Equal(t, "cnpj **.***.***/****-95", Texto("cnpj 11.222.333/0001-95"))
+	assert.Equal(t, "cnpj **.***.***/****-35", Texto("cnpj 12.ABC.345/01DE-35"))
+	assert.Equal(t, "cnpj ************35", Texto("cnpj 12ABC34501DE35"))
+}
+
+func TestNewCore(t *testing.T) {
+	observado, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(NewCore(observado)).With(zap.String("cpf_cnpj", "12345678909"))
+
+	logger.Info("email inválido: fulano@transfeera.com",
+		zap.String("chave", "3d94a1a2-5b0e-4c39-9a8d-6f2c1b7e0a11"),
+		zap.String("request_id", "12345678909"),
+		zap.Error(errors.New("chave 12345678909 já cadastrada")),
+		zap.Any("email", "fulano@transfeera.com"),
+		zap.Uint("recebedor_id", 42),
+	)
+
+	entrada := logs.All()[0]
+	campos := entrada.ContextMap()
+	assert.Equal(t, "email inválido: f*****@transfeera.com", entrada.Message)
+	assert.Equal(t, "*********09", campos["cpf_cnpj"])
+	assert.Equal(t, "********-****-****-****-**********11", campos["chave"])
+	assert.Equal(t, "12345678909", campos["request_id"])
+	assert.Equal(t, "chave *********09 já cadastrada", campos["error"])
+	assert.Equal(t, "f*****@transfeera.com", campos["email"])
+	assert.Equal(t, uint64(42), campos["recebedor_id"])
+}
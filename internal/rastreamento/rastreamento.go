@@ -0,0 +1,90 @@
+package rastreamento
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/flaviorodolfo/transfeera-challenge/internal/versao"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	ExportadorNenhum = ""
+	ExportadorOtlp   = "otlp"
+	ExportadorStdout = "stdout"
+
+	NomeServico = "transfeera-challenge"
+)
+
+// Inicializar configura o provedor global de traces com o exportador informado e a propagação
+// do contexto no formato W3C (traceparent). Sem exportador os spans não são registrados, mas o
+// contexto recebido continua sendo propagado. Retorna a função que envia os spans pendentes e
+// encerra o provedor
+func Inicializar(ctx context.Context, exportador, endpoint string, amostragem float64) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exportador {
+	case ExportadorNenhum:
+		return func(context.Context) error { return nil }, nil
+	case ExportadorOtlp:
+		//sem endpoint é usado OTEL_EXPORTER_OTLP_ENDPOINT ou o padrão localhost:4318
+		opcoes := []otlptracehttp.Option{}
+		if endpoint != "" {
+			opcoes = append(opcoes, otlptracehttp.WithEndpointURL(endpoint))
+		}
+		exporter, err = otlptracehttp.New(ctx, opcoes...)
+	case ExportadorStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("exportador de traces desconhecido: %s", exportador)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recurso, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(NomeServico),
+		semconv.ServiceVersion(versao.Obter().Versao),
+	))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(recurso),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(amostragem))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+type chaveLogger struct{}
+
+// ComLogger retorna o contexto com o logger da requisição, usado por Logger no lugar do logger informado
+func ComLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, chaveLogger{}, logger)
+}
+
+// Logger retorna o logger da requisição presente no contexto, ou o logger informado, com o
+// trace_id e o span_id do span atual, permitindo relacionar os logs com o trace da requisição
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	if requisicao, ok := ctx.Value(chaveLogger{}).(*zap.Logger); ok {
+		logger = requisicao
+	}
+	span := trace.SpanContextFromContext(ctx)
+	if !span.IsValid() {
+		return logger
+	}
+	return logger.With(zap.String("trace_id", span.TraceID().String()), zap.String("span_id", span.SpanID().String()))
+}
@@ -0,0 +1,42 @@
+package rastreamento
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger_ComSpan(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	provider := sdktrace.NewTracerProvider()
+	ctx, span := provider.Tracer("teste").Start(context.Background(), "operacao")
+	defer span.End()
+
+	Logger(ctx, zap.New(core)).Info("mensagem")
+
+	campos := logs.All()[0].ContextMap()
+	assert.Equal(t, span.SpanContext().TraceID().String(), campos["trace_id"])
+	assert.Equal(t, span.SpanContext().SpanID().String(), campos["span_id"])
+}
+
+func TestLogger_SemSpan(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	Logger(context.Background(), zap.New(core)).Info("mensagem")
+	assert.Empty(t, logs.All()[0].ContextMap())
+}
+
+func TestLogger_DaRequisicao(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	ctx := ComLogger(context.Background(), zap.New(core).With(zap.String("request_id", "abc")))
+	Logger(ctx, zap.NewNop()).Info("mensagem")
+	assert.Equal(t, "abc", logs.All()[0].ContextMap()["request_id"])
+}
+
+func TestInicializar_ExportadorDesconhecido(t *testing.T) {
+	_, err := Inicializar(context.Background(), "jaeger", "", 1)
+	assert.Error(t, err)
+}
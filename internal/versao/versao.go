@@ -0,0 +1,37 @@
+package versao
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// valores definidos no build com -ldflags "-X github.com/flaviorodolfo/transfeera-challenge/internal/versao.Versao=..."
+var (
+	Versao    = "dev"
+	Commit    = ""
+	DataBuild = ""
+)
+
+type Info struct {
+	Versao    string `json:"versao"`
+	Commit    string `json:"commit,omitempty"`
+	DataBuild string `json:"data_build,omitempty"`
+	GoVersao  string `json:"go_versao"`
+}
+
+// retorna as informações do build, o commit e a data são obtidos das informações de vcs
+// embutidas pelo go build quando não definidos via ldflags
+func Obter() Info {
+	info := Info{Versao: Versao, Commit: Commit, DataBuild: DataBuild, GoVersao: runtime.Version()}
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range build.Settings {
+			switch {
+			case s.Key == "vcs.revision" && info.Commit == "":
+				info.Commit = s.Value
+			case s.Key == "vcs.time" && info.DataBuild == "":
+				info.DataBuild = s.Value
+			}
+		}
+	}
+	return info
+}